@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"io"
+	"time"
+)
+
+// logRecord is one request parsed from an access log line
+type logRecord struct {
+	Time   time.Time
+	Method string
+	Path   string
+	Status int
+	// Status and response length of every upstream tried, as logged by
+	// nginx ("502, 504") or Traefik; empty when the format has none
+	UpstreamStatus string
+	UpstreamLength string
+	// Envoy response flags, "-" for none
+	Flags string
+}
+
+// parseCombinedLogLine parses an nginx/Apache common or combined log line
+func parseCombinedLogLine(line string) (logRecord, bool) {
+	return combinedLogFormat.Parse(line)
+}
+
+// statusStats counts the status codes of parsed log records, and per time
+// bucket when BucketSize is set
+type statusStats struct {
+	Total   int
+	Skipped int
+	Counts  map[int]int
+
+	BucketSize time.Duration
+	// Status counts per bucket, keyed by the bucket's start in Unix seconds
+	Buckets map[int64]map[int]int
+	// Records without a timestamp, left out of the buckets
+	Undated int
+
+	// Templates request paths when counting per endpoint; nil to skip it
+	Templater *pathTemplater
+	// Status counts per endpoint, keyed by "METHOD /path/{id}"
+	Endpoints map[string]map[int]int
+
+	// Keeps the records to count, e.g. those of a time range; nil keeps all
+	Filter func(logRecord) bool
+
+	// Whether error responses are attributed to the proxy or the upstream
+	Attribute bool
+	// Error responses per status and attribution pattern
+	Attribution map[attributionKey]int
+
+	// Status counts per Envoy response flag, for logs that have them
+	EnvoyFlags map[string]map[int]int
+	// Records without a response (Envoy's status 0), left out of Total and
+	// only counted per response flag and attribution pattern
+	NoResponse int
+}
+
+func newStatusStats() *statusStats {
+	return &statusStats{
+		Counts:    make(map[int]int),
+		Buckets:   make(map[int64]map[int]int),
+		Endpoints: make(map[string]map[int]int),
+
+		Attribution: make(map[attributionKey]int),
+		EnvoyFlags:  make(map[string]map[int]int),
+	}
+}
+
+// add counts one record
+func (s *statusStats) add(record logRecord) {
+	if s.Filter != nil && !s.Filter(record) {
+		return
+	}
+	if record.Status == 0 {
+		s.NoResponse++
+		s.explain(record)
+		return
+	}
+	s.Total++
+	s.Counts[record.Status]++
+
+	if s.Templater != nil && record.Path != "" {
+		method := record.Method
+		if method == "" {
+			method = "-"
+		}
+		s.endpoint(method + " " + s.Templater.template(record.Path))[record.Status]++
+	}
+
+	s.explain(record)
+
+	if s.BucketSize > 0 {
+		if record.Time.IsZero() {
+			s.Undated++
+			return
+		}
+		s.bucket(record.Time.Truncate(s.BucketSize).Unix())[record.Status]++
+	}
+}
+
+// endpoint returns the status counts of an endpoint
+func (s *statusStats) endpoint(key string) map[int]int {
+	counts, ok := s.Endpoints[key]
+	if !ok {
+		counts = make(map[int]int)
+		s.Endpoints[key] = counts
+	}
+	return counts
+}
+
+// explain counts what explains a record: its attribution when it is an error
+// or got no response, and its Envoy response flags
+func (s *statusStats) explain(record logRecord) {
+	if s.Attribute && (record.Status >= 400 || record.Status == 0) {
+		s.Attribution[attributeError(record)]++
+	}
+	for _, flag := range splitEnvoyFlags(record.Flags) {
+		s.envoyFlag(flag)[record.Status]++
+	}
+}
+
+// envoyFlag returns the status counts of an Envoy response flag
+func (s *statusStats) envoyFlag(flag string) map[int]int {
+	counts, ok := s.EnvoyFlags[flag]
+	if !ok {
+		counts = make(map[int]int)
+		s.EnvoyFlags[flag] = counts
+	}
+	return counts
+}
+
+// bucket returns the status counts of the bucket starting at start
+func (s *statusStats) bucket(start int64) map[int]int {
+	counts, ok := s.Buckets[start]
+	if !ok {
+		counts = make(map[int]int)
+		s.Buckets[start] = counts
+	}
+	return counts
+}
+
+// merge adds the counts of other
+func (s *statusStats) merge(other *statusStats) {
+	s.Total += other.Total
+	s.Skipped += other.Skipped
+	s.Undated += other.Undated
+	s.NoResponse += other.NoResponse
+	for code, count := range other.Counts {
+		s.Counts[code] += count
+	}
+	for start, counts := range other.Buckets {
+		bucket := s.bucket(start)
+		for code, count := range counts {
+			bucket[code] += count
+		}
+	}
+	for key, counts := range other.Endpoints {
+		endpoint := s.endpoint(key)
+		for code, count := range counts {
+			endpoint[code] += count
+		}
+	}
+	for key, count := range other.Attribution {
+		s.Attribution[key] += count
+	}
+	for flag, counts := range other.EnvoyFlags {
+		envoyFlag := s.envoyFlag(flag)
+		for code, count := range counts {
+			envoyFlag[code] += count
+		}
+	}
+}
+
+// classCount returns the number of records in a status class (1 for 1xx, ...)
+func (s *statusStats) classCount(class int) int {
+	count := 0
+	for code, n := range s.Counts {
+		if code/100 == class {
+			count += n
+		}
+	}
+	return count
+}
+
+// rate returns a count as a fraction of all records
+func (s *statusStats) rate(count int) float64 {
+	if s.Total == 0 {
+		return 0
+	}
+	return float64(count) / float64(s.Total)
+}
+
+// errorRate returns the fraction of 4xx and 5xx responses
+func (s *statusStats) errorRate() float64 {
+	return s.rate(s.classCount(4) + s.classCount(5))
+}
+
+// collectStatusStats parses every line of an access log into stats, in one
+// of the given formats. Lines in an unknown format are counted as skipped.
+func collectStatusStats(r io.Reader, formats []logFormat, stats *statusStats) error {
+	skipped, err := scanLogRecords(r, formats, stats.add)
+	stats.Skipped += skipped
+	return err
+}
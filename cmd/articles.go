@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"embed"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Long-form Markdown articles, one per registered status code
+//
+//go:embed articles/*.md
+var articleFiles embed.FS
+
+// Inline Markdown spans supported in articles: `code` and **bold**
+var articleInlinePattern = regexp.MustCompile("`[^`]+`|\\*\\*[^*]+\\*\\*")
+
+// loadArticle returns the Markdown article for a status code
+func loadArticle(code int) (string, error) {
+	data, err := articleFiles.ReadFile(fmt.Sprintf("articles/%d.md", code))
+	if err != nil {
+		return "", fmt.Errorf("no article for HTTP status code %d", code)
+	}
+	return string(data), nil
+}
+
+// renderArticle renders an article's Markdown for the terminal. Articles use
+// a small subset of Markdown: headings, paragraphs, bullet lists, fenced code
+// blocks, inline code and bold text.
+func renderArticle(code int, markdown string) string {
+	color := getStatusCodeColor(code)
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(color)
+	headingStyle := lipgloss.NewStyle().Bold(true).Foreground(color)
+	codeBlockStyle := lipgloss.NewStyle().Foreground(mutedColor)
+
+	var out strings.Builder
+	inCodeBlock := false
+	for _, line := range strings.Split(strings.TrimRight(markdown, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "```"):
+			inCodeBlock = !inCodeBlock
+			continue
+		case inCodeBlock:
+			out.WriteString(codeBlockStyle.Render("    "+line) + "\n")
+		case strings.HasPrefix(line, "# "):
+			out.WriteString(titleStyle.Render("           HTTP "+strings.TrimPrefix(line, "# ")) + "\n")
+		case strings.HasPrefix(line, "## "):
+			out.WriteString(headingStyle.Render("▌ "+strings.TrimPrefix(line, "## ")) + "\n")
+		case strings.HasPrefix(line, "- "):
+			out.WriteString("  • " + renderArticleInline(strings.TrimPrefix(line, "- ")) + "\n")
+		case strings.HasPrefix(line, "  "):
+			out.WriteString("    " + renderArticleInline(strings.TrimSpace(line)) + "\n")
+		default:
+			out.WriteString(renderArticleInline(line) + "\n")
+		}
+	}
+	return out.String()
+}
+
+// renderArticleInline styles inline code and bold spans in a line of text
+func renderArticleInline(text string) string {
+	return articleInlinePattern.ReplaceAllStringFunc(text, func(span string) string {
+		if strings.HasPrefix(span, "**") {
+			return lipgloss.NewStyle().Bold(true).Render(strings.Trim(span, "*"))
+		}
+		return lipgloss.NewStyle().Foreground(linkColor).Render(strings.Trim(span, "`"))
+	})
+}
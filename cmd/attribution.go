@@ -0,0 +1,259 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Whether stats attributes errors to the proxy or the upstream, set with
+// --upstream
+var statsUpstream bool
+
+// Where an error response came from
+const (
+	originProxy    = "proxy"
+	originUpstream = "upstream"
+	originUnknown  = "unknown"
+)
+
+// errorPattern is a known way for a proxy to produce or pass on an error
+type errorPattern struct {
+	Name        string
+	Origin      string
+	Explanation string
+}
+
+// Error patterns recognized in proxy access logs
+var errorPatterns = map[string]errorPattern{
+	"client-abort": {
+		Name:        "client abort",
+		Origin:      originProxy,
+		Explanation: "The client closed the connection before the response was sent; nginx logs 499, Envoy a DC flag. Usually a client timeout shorter than the upstream's response time.",
+	},
+	"no-upstream": {
+		Name:        "answered by the proxy",
+		Origin:      originProxy,
+		Explanation: "No upstream was contacted: the proxy answered itself, e.g. a rate limit, an invalid request, a static location or no live upstream.",
+	},
+	"connect-failure": {
+		Name:        "upstream connect failure",
+		Origin:      originProxy,
+		Explanation: "The proxy could not connect to the upstream or got no valid response (refused, reset or closed connection, invalid headers), so it generated the 502.",
+	},
+	"upstream-timeout": {
+		Name:        "upstream timeout",
+		Origin:      originProxy,
+		Explanation: "The upstream did not answer within the proxy's timeout (proxy_read_timeout, proxy_connect_timeout), so the proxy generated the 504.",
+	},
+	"retries-exhausted": {
+		Name:        "retries exhausted",
+		Origin:      originProxy,
+		Explanation: "The proxy tried several upstreams (proxy_next_upstream) and every attempt failed; the status is that of the last attempt.",
+	},
+	"rewritten": {
+		Name:        "status replaced by the proxy",
+		Origin:      originProxy,
+		Explanation: "The proxy answered with a different status than the upstream's, e.g. through error_page with proxy_intercept_errors.",
+	},
+	"gateway-error": {
+		Name:        "gateway error, origin unclear",
+		Origin:      originUnknown,
+		Explanation: "nginx logs 502 and 504 as $upstream_status both when the upstream sent them and when it failed to get a response; log $upstream_response_length to tell them apart.",
+	},
+	"passthrough": {
+		Name:        "passed through",
+		Origin:      originUpstream,
+		Explanation: "The upstream sent this status and the proxy passed it on unchanged.",
+	},
+	"envoy-flags": {
+		Name:        "generated by Envoy",
+		Origin:      originProxy,
+		Explanation: "Envoy generated the response; its response flags give the reason.",
+	},
+	"no-data": {
+		Name:        "no upstream data",
+		Origin:      originUnknown,
+		Explanation: "The log format has no upstream status or response flags; use a format with $upstream_status (e.g. ingress-nginx) or Envoy's %RESPONSE_FLAGS%.",
+	},
+}
+
+// attributionKey counts errors by status, pattern and Envoy flags
+type attributionKey struct {
+	Status  int
+	Pattern string
+	Flags   string
+}
+
+// parseUpstreamList splits an nginx upstream variable into one value per
+// upstream tried; "-" marks an upstream without a value
+func parseUpstreamList(value string) []string {
+	value = strings.TrimSpace(value)
+	if value == "" || value == "-" {
+		return nil
+	}
+	var values []string
+	for _, group := range strings.Split(value, " : ") {
+		for _, v := range strings.Split(group, ",") {
+			values = append(values, strings.TrimSpace(v))
+		}
+	}
+	return values
+}
+
+// attributeError classifies an error response as generated by the proxy or
+// passed through from the upstream, returning its pattern
+func attributeError(record logRecord) attributionKey {
+	key := attributionKey{Status: record.Status}
+
+	// Envoy: response flags say whether Envoy generated the response
+	if record.Flags != "" {
+		switch {
+		case record.Flags == "-":
+			key.Pattern = "passthrough"
+		case record.Status == 499 || hasEnvoyFlag(record.Flags, "DC"):
+			key.Pattern, key.Flags = "client-abort", record.Flags
+		default:
+			key.Pattern, key.Flags = "envoy-flags", record.Flags
+		}
+		return key
+	}
+
+	if record.Status == 499 {
+		key.Pattern = "client-abort"
+		return key
+	}
+	if record.UpstreamStatus == "" {
+		key.Pattern = "no-data"
+		return key
+	}
+
+	statuses := parseUpstreamList(record.UpstreamStatus)
+	// Traefik logs OriginStatus 0 when no upstream responded
+	if len(statuses) == 0 || (len(statuses) == 1 && statuses[0] == "0") {
+		if record.Status == 502 || record.Status == 504 {
+			key.Pattern = map[int]string{502: "connect-failure", 504: "upstream-timeout"}[record.Status]
+		} else {
+			key.Pattern = "no-upstream"
+		}
+		return key
+	}
+
+	last, err := strconv.Atoi(statuses[len(statuses)-1])
+	switch {
+	case err != nil || last != record.Status:
+		key.Pattern = "rewritten"
+	case len(statuses) > 1:
+		key.Pattern = "retries-exhausted"
+	case last == 502 || last == 504:
+		lengths := parseUpstreamList(record.UpstreamLength)
+		switch {
+		case len(lengths) == 0:
+			key.Pattern = "gateway-error"
+		case lengths[len(lengths)-1] == "0":
+			key.Pattern = map[int]string{502: "connect-failure", 504: "upstream-timeout"}[last]
+		default:
+			key.Pattern = "passthrough"
+		}
+	default:
+		key.Pattern = "passthrough"
+	}
+	return key
+}
+
+// hasEnvoyFlag reports whether a response flags value has a flag
+func hasEnvoyFlag(flags, flag string) bool {
+	for _, f := range splitEnvoyFlags(flags) {
+		if f == flag {
+			return true
+		}
+	}
+	return false
+}
+
+// originCounts sums the attributed errors per origin
+func (s *statusStats) originCounts() map[string]int {
+	counts := make(map[string]int)
+	for key, count := range s.Attribution {
+		counts[errorPatterns[key.Pattern].Origin] += count
+	}
+	return counts
+}
+
+// originLabel describes an origin in a pattern line
+func originLabel(origin string) string {
+	switch origin {
+	case originProxy:
+		return "from the proxy"
+	case originUpstream:
+		return "from the upstream"
+	default:
+		return "origin unknown"
+	}
+}
+
+func displayErrorAttributionWithLipgloss(stats *statusStats) {
+	total := 0
+	for _, count := range stats.Attribution {
+		total += count
+	}
+	displayListHeaderWithLipgloss(fmt.Sprintf("Origin of %d Error Responses", total))
+	if total == 0 {
+		fmt.Println(lipgloss.NewStyle().Foreground(mutedColor).Render("  No 4xx or 5xx responses"))
+		fmt.Println()
+		return
+	}
+
+	origins := stats.originCounts()
+	for _, origin := range []string{originProxy, originUpstream, originUnknown} {
+		if origins[origin] == 0 {
+			continue
+		}
+		fmt.Printf("  %-10s %8d %6s\n", origin, origins[origin], formatPercent(float64(origins[origin])/float64(total)))
+	}
+	fmt.Println()
+
+	var keys []attributionKey
+	for key := range stats.Attribution {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		ci, cj := stats.Attribution[keys[i]], stats.Attribution[keys[j]]
+		if ci != cj {
+			return ci > cj
+		}
+		if keys[i].Status != keys[j].Status {
+			return keys[i].Status < keys[j].Status
+		}
+		return keys[i].Pattern+keys[i].Flags < keys[j].Pattern+keys[j].Flags
+	})
+
+	displayListHeaderWithLipgloss("Error Patterns")
+	for _, key := range keys {
+		pattern := errorPatterns[key.Pattern]
+		name := pattern.Name
+		if key.Flags != "" {
+			name += " (" + key.Flags + ")"
+		}
+		status := fmt.Sprintf("%d %s", key.Status, statusName(key.Status))
+		if key.Status == 0 {
+			status = "no response"
+		}
+		title := lipgloss.NewStyle().
+			Bold(true).
+			Foreground(getStatusCodeColor(key.Status)).
+			Render(fmt.Sprintf("  %s · %s", status, name))
+		fmt.Printf("%s %s\n", title, lipgloss.NewStyle().
+			Foreground(mutedColor).
+			Render(fmt.Sprintf("%d requests, %s", stats.Attribution[key], originLabel(pattern.Origin))))
+		explanation := pattern.Explanation
+		if key.Pattern == "envoy-flags" {
+			explanation = describeEnvoyFlags(key.Flags)
+		}
+		fmt.Println(lipgloss.NewStyle().Foreground(textColor).Render("    " + explanation))
+	}
+	fmt.Println()
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// Sample ingress-nginx log with proxy and upstream errors
+const sampleIngressLog = `10.0.0.1 - - [19/Oct/2026:10:00:01 +0000] "GET /a HTTP/1.1" 502 150 "-" "curl/8.5.0" 80 0.001 [default-api-80] [] 10.244.0.5:8080 0 0.001 502 a1
+10.0.0.1 - - [19/Oct/2026:10:00:01 +0000] "GET /a HTTP/1.1" 502 150 "-" "curl/8.5.0" 80 0.001 [default-api-80] [] 10.244.0.5:8080 0 0.001 502 a2
+10.0.0.1 - - [19/Oct/2026:10:00:02 +0000] "GET /a HTTP/1.1" 504 150 "-" "curl/8.5.0" 80 60.0 [default-api-80] [] 10.244.0.5:8080, 10.244.0.6:8080 0, 0 30.0, 30.0 504, 504 a3
+10.0.0.1 - - [19/Oct/2026:10:00:03 +0000] "GET /a HTTP/1.1" 500 150 "-" "curl/8.5.0" 80 0.001 [default-api-80] [] 10.244.0.5:8080 64 0.001 500 a4
+10.0.0.1 - - [19/Oct/2026:10:00:04 +0000] "GET /a HTTP/1.1" 499 0 "-" "curl/8.5.0" 80 5.0 [default-api-80] [] 10.244.0.5:8080 0 5.0 - a5
+10.0.0.1 - - [19/Oct/2026:10:00:05 +0000] "GET /a HTTP/1.1" 503 190 "-" "curl/8.5.0" 80 0.000 [default-api-80] [] - - - - a6
+10.0.0.1 - - [19/Oct/2026:10:00:06 +0000] "GET /a HTTP/1.1" 200 150 "-" "curl/8.5.0" 80 0.010 [default-api-80] [] 10.244.0.5:8080 150 0.010 200 a7
+`
+
+func TestParseUpstreamList(t *testing.T) {
+	tests := map[string][]string{
+		"":               nil,
+		"-":              nil,
+		"200":            {"200"},
+		"502, 504":       {"502", "504"},
+		"502, 504 : 200": {"502", "504", "200"},
+		"-, 200":         {"-", "200"},
+		"0":              {"0"},
+		"10.0.0.1:80, -": {"10.0.0.1:80", "-"},
+	}
+	for value, want := range tests {
+		if got := parseUpstreamList(value); !reflect.DeepEqual(got, want) {
+			t.Errorf("parseUpstreamList(%q) = %q, want %q", value, got, want)
+		}
+	}
+}
+
+func TestAttributeError(t *testing.T) {
+	tests := []struct {
+		name        string
+		record      logRecord
+		wantPattern string
+		wantFlags   string
+	}{
+		{name: "nginx client abort", record: logRecord{Status: 499, UpstreamStatus: "-"}, wantPattern: "client-abort"},
+		{name: "no upstream contacted", record: logRecord{Status: 503, UpstreamStatus: "-"}, wantPattern: "no-upstream"},
+		{name: "connect failure", record: logRecord{Status: 502, UpstreamStatus: "502", UpstreamLength: "0"}, wantPattern: "connect-failure"},
+		{name: "timeout", record: logRecord{Status: 504, UpstreamStatus: "504", UpstreamLength: "0"}, wantPattern: "upstream-timeout"},
+		{name: "upstream 502 with a body", record: logRecord{Status: 502, UpstreamStatus: "502", UpstreamLength: "173"}, wantPattern: "passthrough"},
+		{name: "502 without length", record: logRecord{Status: 502, UpstreamStatus: "502"}, wantPattern: "gateway-error"},
+		{name: "retries", record: logRecord{Status: 504, UpstreamStatus: "502, 504", UpstreamLength: "0, 0"}, wantPattern: "retries-exhausted"},
+		{name: "replaced by error_page", record: logRecord{Status: 500, UpstreamStatus: "404"}, wantPattern: "rewritten"},
+		{name: "passed through", record: logRecord{Status: 500, UpstreamStatus: "500"}, wantPattern: "passthrough"},
+		{name: "traefik without origin", record: logRecord{Status: 502, UpstreamStatus: "0"}, wantPattern: "connect-failure"},
+		{name: "no upstream data", record: logRecord{Status: 500}, wantPattern: "no-data"},
+		{name: "envoy passthrough", record: logRecord{Status: 503, Flags: "-"}, wantPattern: "passthrough"},
+		{name: "envoy flags", record: logRecord{Status: 503, Flags: "UF,URX"}, wantPattern: "envoy-flags", wantFlags: "UF,URX"},
+		{name: "envoy downstream disconnect", record: logRecord{Status: 503, Flags: "DC"}, wantPattern: "client-abort", wantFlags: "DC"},
+		{name: "envoy space-separated flags", record: logRecord{Status: 503, Flags: "UF DC"}, wantPattern: "client-abort", wantFlags: "UF DC"},
+		{name: "envoy no response", record: logRecord{Status: 0, Flags: "DC"}, wantPattern: "client-abort", wantFlags: "DC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := attributeError(tt.record)
+			if key.Pattern != tt.wantPattern || key.Flags != tt.wantFlags || key.Status != tt.record.Status {
+				t.Errorf("attributeError() = %+v, want pattern %s flags %q", key, tt.wantPattern, tt.wantFlags)
+			}
+			if _, ok := errorPatterns[key.Pattern]; !ok {
+				t.Errorf("Pattern %s has no explanation", key.Pattern)
+			}
+		})
+	}
+}
+
+func TestStatsUpstream(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "access.log")
+	if err := os.WriteFile(path, []byte(sampleIngressLog), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { statsUpstream = false }()
+
+	stdout, _ := captureOutput(func() {
+		rootCmd.SetArgs([]string{"stats", "--upstream", path})
+		rootCmd.Execute()
+	})
+
+	expected := []string{
+		"Origin of 6 Error Responses",
+		"proxy             5  83.3%",
+		"upstream          1  16.7%",
+		"502 Bad Gateway · upstream connect failure",
+		"2 requests, from the proxy",
+		"504 Gateway Timeout · retries exhausted",
+		"500 Internal Server Error · passed through",
+		"503 Service Unavailable · answered by the proxy",
+		"499 Client Closed Request · client abort",
+	}
+	for _, e := range expected {
+		if !strings.Contains(stdout, e) {
+			t.Errorf("Expected '%s' in output, got: %s", e, stdout)
+		}
+	}
+}
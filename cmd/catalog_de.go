@@ -0,0 +1,67 @@
+package cmd
+
+// German status code messages
+var deMessages = map[int]codeMessage{
+	100: {"Weiter", ""},
+	101: {"Protokollwechsel", ""},
+	102: {"Wird verarbeitet", ""},
+	103: {"Frühe Hinweise", ""},
+	200: {"OK", "Die Anfrage war erfolgreich. Die Bedeutung des Ergebnisses hängt von der HTTP-Methode ab."},
+	201: {"Erstellt", "Die Anfrage war erfolgreich und eine neue Ressource wurde erstellt. Typischerweise die Antwort auf POST- oder PUT-Anfragen."},
+	202: {"Akzeptiert", ""},
+	203: {"Nicht autoritative Information", ""},
+	204: {"Kein Inhalt", "Die Anfrage war erfolgreich, aber es gibt keinen Inhalt für die Antwort. Die Header können dennoch nützlich sein."},
+	205: {"Inhalt zurücksetzen", ""},
+	206: {"Teilinhalt", ""},
+	207: {"Mehrfachstatus", ""},
+	208: {"Bereits gemeldet", ""},
+	226: {"IM verwendet", ""},
+	300: {"Mehrfachauswahl", ""},
+	301: {"Dauerhaft verschoben", "Die URL der angeforderten Ressource wurde dauerhaft geändert. Die neue URL wird in der Antwort angegeben."},
+	302: {"Gefunden", "Die URI der angeforderten Ressource wurde vorübergehend geändert. Der Client sollte für künftige Anfragen dieselbe URI verwenden."},
+	303: {"Siehe andere", ""},
+	304: {"Nicht geändert", "Wird für das Caching verwendet: Die Antwort hat sich nicht geändert, der Client kann die zwischengespeicherte Version weiter nutzen."},
+	305: {"Proxy verwenden", ""},
+	307: {"Temporäre Umleitung", ""},
+	308: {"Permanente Umleitung", ""},
+	400: {"Ungültige Anfrage", "Der Server kann oder will die Anfrage wegen eines Client-Fehlers nicht verarbeiten, z. B. wegen fehlerhafter Syntax."},
+	401: {"Nicht autorisiert", "Der Client muss sich authentifizieren, um die angeforderte Antwort zu erhalten."},
+	402: {"Zahlung erforderlich", ""},
+	403: {"Verboten", "Der Client hat keine Zugriffsrechte auf den Inhalt. Anders als bei 401 ist die Identität des Clients dem Server bekannt."},
+	404: {"Nicht gefunden", "Der Server findet die angeforderte Ressource nicht. In einer API kann der Endpunkt gültig sein, die Ressource selbst aber nicht existieren."},
+	405: {"Methode nicht erlaubt", ""},
+	406: {"Nicht annehmbar", ""},
+	407: {"Proxy-Authentifizierung erforderlich", ""},
+	408: {"Zeitüberschreitung der Anfrage", ""},
+	409: {"Konflikt", "Die Anfrage steht im Konflikt mit dem aktuellen Zustand des Servers."},
+	410: {"Nicht mehr verfügbar", ""},
+	411: {"Länge erforderlich", ""},
+	412: {"Vorbedingung fehlgeschlagen", ""},
+	413: {"Inhalt zu groß", ""},
+	414: {"URI zu lang", ""},
+	415: {"Nicht unterstützter Medientyp", ""},
+	416: {"Bereich nicht erfüllbar", ""},
+	417: {"Erwartung fehlgeschlagen", ""},
+	418: {"Ich bin eine Teekanne", ""},
+	421: {"Fehlgeleitete Anfrage", ""},
+	422: {"Nicht verarbeitbarer Inhalt", ""},
+	423: {"Gesperrt", ""},
+	424: {"Fehlgeschlagene Abhängigkeit", ""},
+	425: {"Zu früh", ""},
+	426: {"Upgrade erforderlich", ""},
+	428: {"Vorbedingung erforderlich", ""},
+	429: {"Zu viele Anfragen", "Der Benutzer hat in einem bestimmten Zeitraum zu viele Anfragen gesendet (Ratenbegrenzung)."},
+	431: {"Header-Felder der Anfrage zu groß", ""},
+	451: {"Aus rechtlichen Gründen nicht verfügbar", ""},
+	500: {"Interner Serverfehler", "Der Server ist auf eine Situation gestoßen, die er nicht zu behandeln weiß."},
+	501: {"Nicht implementiert", ""},
+	502: {"Fehlerhaftes Gateway", "Der Server hat als Gateway eine ungültige Antwort vom vorgelagerten Server erhalten."},
+	503: {"Dienst nicht verfügbar", "Der Server ist nicht bereit, die Anfrage zu bearbeiten, meist wegen Wartung oder Überlastung."},
+	504: {"Gateway-Zeitüberschreitung", "Der Server hat als Gateway nicht rechtzeitig eine Antwort vom vorgelagerten Server erhalten."},
+	505: {"HTTP-Version nicht unterstützt", ""},
+	506: {"Variante verhandelt ebenfalls", ""},
+	507: {"Unzureichender Speicher", ""},
+	508: {"Schleife erkannt", ""},
+	510: {"Nicht erweitert", ""},
+	511: {"Netzwerkauthentifizierung erforderlich", ""},
+}
@@ -0,0 +1,67 @@
+package cmd
+
+// Spanish status code messages
+var esMessages = map[int]codeMessage{
+	100: {"Continuar", ""},
+	101: {"Cambiando protocolos", ""},
+	102: {"Procesando", ""},
+	103: {"Indicios tempranos", ""},
+	200: {"OK", "La solicitud se ha completado correctamente. El significado del resultado depende del método HTTP."},
+	201: {"Creado", "La solicitud se ha completado y se ha creado un nuevo recurso. Suele ser la respuesta a una solicitud POST o PUT."},
+	202: {"Aceptado", ""},
+	203: {"Información no autoritativa", ""},
+	204: {"Sin contenido", "La solicitud se ha completado, pero no hay contenido que enviar en la respuesta. Los encabezados pueden ser útiles."},
+	205: {"Restablecer contenido", ""},
+	206: {"Contenido parcial", ""},
+	207: {"Multiestado", ""},
+	208: {"Ya reportado", ""},
+	226: {"IM usado", ""},
+	300: {"Múltiples opciones", ""},
+	301: {"Movido permanentemente", "La URL del recurso solicitado ha cambiado de forma permanente. La nueva URL se indica en la respuesta."},
+	302: {"Encontrado", "La URI del recurso solicitado ha cambiado temporalmente. El cliente debe seguir usando la misma URI en futuras solicitudes."},
+	303: {"Ver otro", ""},
+	304: {"No modificado", "Se usa para la caché: la respuesta no ha cambiado, así que el cliente puede seguir usando la versión almacenada."},
+	305: {"Usar proxy", ""},
+	307: {"Redirección temporal", ""},
+	308: {"Redirección permanente", ""},
+	400: {"Solicitud incorrecta", "El servidor no puede o no quiere procesar la solicitud debido a un error del cliente, por ejemplo una sintaxis incorrecta."},
+	401: {"No autorizado", "El cliente debe autenticarse para obtener la respuesta solicitada."},
+	402: {"Pago requerido", ""},
+	403: {"Prohibido", "El cliente no tiene permisos para acceder al contenido. A diferencia de 401, el servidor conoce la identidad del cliente."},
+	404: {"No encontrado", "El servidor no encuentra el recurso solicitado. En una API, el endpoint puede ser válido pero el recurso no existir."},
+	405: {"Método no permitido", ""},
+	406: {"No aceptable", ""},
+	407: {"Autenticación de proxy requerida", ""},
+	408: {"Tiempo de espera de la solicitud agotado", ""},
+	409: {"Conflicto", "La solicitud entra en conflicto con el estado actual del servidor."},
+	410: {"Ya no disponible", ""},
+	411: {"Longitud requerida", ""},
+	412: {"Falló la condición previa", ""},
+	413: {"Contenido demasiado grande", ""},
+	414: {"URI demasiado largo", ""},
+	415: {"Tipo de medio no soportado", ""},
+	416: {"Rango no satisfactorio", ""},
+	417: {"Falló la expectativa", ""},
+	418: {"Soy una tetera", ""},
+	421: {"Solicitud mal dirigida", ""},
+	422: {"Contenido no procesable", ""},
+	423: {"Bloqueado", ""},
+	424: {"Dependencia fallida", ""},
+	425: {"Demasiado pronto", ""},
+	426: {"Actualización requerida", ""},
+	428: {"Condición previa requerida", ""},
+	429: {"Demasiadas solicitudes", "El usuario ha enviado demasiadas solicitudes en un periodo de tiempo determinado (limitación de tasa)."},
+	431: {"Campos de encabezado de solicitud demasiado grandes", ""},
+	451: {"No disponible por razones legales", ""},
+	500: {"Error interno del servidor", "El servidor se ha encontrado con una situación que no sabe cómo manejar."},
+	501: {"No implementado", ""},
+	502: {"Puerta de enlace incorrecta", "El servidor, actuando como puerta de enlace, recibió una respuesta no válida del servidor de origen."},
+	503: {"Servicio no disponible", "El servidor no está listo para atender la solicitud, normalmente por mantenimiento o sobrecarga."},
+	504: {"Tiempo de espera de la puerta de enlace agotado", "El servidor, actuando como puerta de enlace, no recibió a tiempo una respuesta del servidor de origen."},
+	505: {"Versión de HTTP no soportada", ""},
+	506: {"La variante también negocia", ""},
+	507: {"Almacenamiento insuficiente", ""},
+	508: {"Bucle detectado", ""},
+	510: {"No extendido", ""},
+	511: {"Autenticación de red requerida", ""},
+}
@@ -0,0 +1,67 @@
+package cmd
+
+// French status code messages
+var frMessages = map[int]codeMessage{
+	100: {"Continuer", ""},
+	101: {"Changement de protocole", ""},
+	102: {"Traitement en cours", ""},
+	103: {"Indications préliminaires", ""},
+	200: {"OK", "La requête a réussi. La signification du résultat dépend de la méthode HTTP."},
+	201: {"Créé", "La requête a réussi et une nouvelle ressource a été créée. C'est généralement la réponse à une requête POST ou PUT."},
+	202: {"Accepté", ""},
+	203: {"Information non certifiée", ""},
+	204: {"Pas de contenu", "La requête a réussi, mais il n'y a aucun contenu à envoyer dans la réponse. Les en-têtes peuvent être utiles."},
+	205: {"Contenu réinitialisé", ""},
+	206: {"Contenu partiel", ""},
+	207: {"Multi-statut", ""},
+	208: {"Déjà signalé", ""},
+	226: {"IM utilisé", ""},
+	300: {"Choix multiples", ""},
+	301: {"Déplacé de façon permanente", "L'URL de la ressource demandée a changé de façon permanente. La nouvelle URL est indiquée dans la réponse."},
+	302: {"Trouvé", "L'URI de la ressource demandée a changé temporairement. Le client doit continuer à utiliser la même URI pour les requêtes futures."},
+	303: {"Voir ailleurs", ""},
+	304: {"Non modifié", "Utilisé pour le cache : la réponse n'a pas changé, le client peut continuer à utiliser la version en cache."},
+	305: {"Utiliser le proxy", ""},
+	307: {"Redirection temporaire", ""},
+	308: {"Redirection permanente", ""},
+	400: {"Requête incorrecte", "Le serveur ne peut pas ou ne veut pas traiter la requête à cause d'une erreur du client, par exemple une syntaxe invalide."},
+	401: {"Non autorisé", "Le client doit s'authentifier pour obtenir la réponse demandée."},
+	402: {"Paiement requis", ""},
+	403: {"Interdit", "Le client n'a pas les droits d'accès au contenu. Contrairement à 401, l'identité du client est connue du serveur."},
+	404: {"Non trouvé", "Le serveur ne trouve pas la ressource demandée. Dans une API, le point de terminaison peut être valide mais la ressource inexistante."},
+	405: {"Méthode non autorisée", ""},
+	406: {"Non acceptable", ""},
+	407: {"Authentification proxy requise", ""},
+	408: {"Délai d'attente de la requête dépassé", ""},
+	409: {"Conflit", "La requête est en conflit avec l'état actuel du serveur."},
+	410: {"Disparu", ""},
+	411: {"Longueur requise", ""},
+	412: {"Échec de la précondition", ""},
+	413: {"Contenu trop volumineux", ""},
+	414: {"URI trop longue", ""},
+	415: {"Type de média non pris en charge", ""},
+	416: {"Plage non satisfaisable", ""},
+	417: {"Échec de l'attente", ""},
+	418: {"Je suis une théière", ""},
+	421: {"Requête mal dirigée", ""},
+	422: {"Contenu non traitable", ""},
+	423: {"Verrouillé", ""},
+	424: {"Échec de dépendance", ""},
+	425: {"Trop tôt", ""},
+	426: {"Mise à niveau requise", ""},
+	428: {"Précondition requise", ""},
+	429: {"Trop de requêtes", "L'utilisateur a envoyé trop de requêtes dans un laps de temps donné (limitation de débit)."},
+	431: {"Champs d'en-tête de requête trop volumineux", ""},
+	451: {"Indisponible pour raisons légales", ""},
+	500: {"Erreur interne du serveur", "Le serveur a rencontré une situation qu'il ne sait pas gérer."},
+	501: {"Non implémenté", ""},
+	502: {"Mauvaise passerelle", "Le serveur, agissant comme passerelle, a reçu une réponse invalide du serveur en amont."},
+	503: {"Service indisponible", "Le serveur n'est pas prêt à traiter la requête, généralement pour cause de maintenance ou de surcharge."},
+	504: {"Délai d'attente de la passerelle dépassé", "Le serveur, agissant comme passerelle, n'a pas reçu de réponse à temps du serveur en amont."},
+	505: {"Version HTTP non prise en charge", ""},
+	506: {"La variante négocie aussi", ""},
+	507: {"Stockage insuffisant", ""},
+	508: {"Boucle détectée", ""},
+	510: {"Non étendu", ""},
+	511: {"Authentification réseau requise", ""},
+}
@@ -0,0 +1,67 @@
+package cmd
+
+// Japanese status code messages
+var jaMessages = map[int]codeMessage{
+	100: {"継続", ""},
+	101: {"プロトコル切り替え", ""},
+	102: {"処理中", ""},
+	103: {"早期ヒント", ""},
+	200: {"OK", "リクエストが成功しました。結果の意味は HTTP メソッドによって異なります。"},
+	201: {"作成", "リクエストが成功し、新しいリソースが作成されました。通常は POST または PUT リクエストへのレスポンスです。"},
+	202: {"受理", ""},
+	203: {"信頼できない情報", ""},
+	204: {"内容なし", "リクエストは成功しましたが、レスポンスとして送信するコンテンツはありません。ヘッダーは有用な場合があります。"},
+	205: {"内容のリセット", ""},
+	206: {"部分的内容", ""},
+	207: {"複数のステータス", ""},
+	208: {"既に報告", ""},
+	226: {"IM 使用", ""},
+	300: {"複数の選択肢", ""},
+	301: {"恒久的に移動した", "リクエストされたリソースの URL が恒久的に変更されました。新しい URL はレスポンスで示されます。"},
+	302: {"発見した", "リクエストされたリソースの URI が一時的に変更されました。今後のリクエストでは同じ URI を使用してください。"},
+	303: {"他を参照せよ", ""},
+	304: {"未更新", "キャッシュのために使用されます。レスポンスは変更されていないため、クライアントはキャッシュ済みのバージョンを引き続き使用できます。"},
+	305: {"プロキシを使用せよ", ""},
+	307: {"一時的リダイレクト", ""},
+	308: {"恒久的リダイレクト", ""},
+	400: {"不正なリクエスト", "構文の誤りなどクライアント側のエラーにより、サーバーはリクエストを処理できないか、処理しません。"},
+	401: {"認証が必要", "リクエストしたレスポンスを得るには、クライアントが認証を行う必要があります。"},
+	402: {"支払いが必要", ""},
+	403: {"禁止されている", "クライアントにはコンテンツへのアクセス権がありません。401 と異なり、サーバーはクライアントの身元を把握しています。"},
+	404: {"未検出", "サーバーはリクエストされたリソースを見つけられません。API では、エンドポイントは有効でもリソース自体が存在しないことがあります。"},
+	405: {"許可されていないメソッド", ""},
+	406: {"受理できない", ""},
+	407: {"プロキシ認証が必要", ""},
+	408: {"リクエストタイムアウト", ""},
+	409: {"競合", "リクエストがサーバーの現在の状態と競合しています。"},
+	410: {"消滅した", ""},
+	411: {"長さが必要", ""},
+	412: {"前提条件で失敗した", ""},
+	413: {"コンテンツが大きすぎる", ""},
+	414: {"URI が長すぎる", ""},
+	415: {"サポートしていないメディアタイプ", ""},
+	416: {"レンジは範囲外にある", ""},
+	417: {"Expect ヘッダーによる拡張が失敗", ""},
+	418: {"私はティーポット", ""},
+	421: {"誤ったリクエスト先", ""},
+	422: {"処理できないコンテンツ", ""},
+	423: {"ロックされている", ""},
+	424: {"依存関係で失敗", ""},
+	425: {"早すぎる", ""},
+	426: {"アップグレードが必要", ""},
+	428: {"事前条件が必要", ""},
+	429: {"リクエスト過大", "一定時間内に送信されたリクエストが多すぎます (レート制限)。"},
+	431: {"リクエストヘッダーフィールドが大きすぎる", ""},
+	451: {"法的理由により利用不可", ""},
+	500: {"サーバー内部エラー", "サーバーが処理方法の分からない状況に遭遇しました。"},
+	501: {"未実装", ""},
+	502: {"不正なゲートウェイ", "ゲートウェイとして動作しているサーバーが、上流サーバーから不正なレスポンスを受け取りました。"},
+	503: {"サービス利用不可", "サーバーはリクエストを処理する準備ができていません。メンテナンス中や過負荷が一般的な原因です。"},
+	504: {"ゲートウェイタイムアウト", "ゲートウェイとして動作しているサーバーが、上流サーバーから時間内にレスポンスを受け取れませんでした。"},
+	505: {"サポートしていない HTTP バージョン", ""},
+	506: {"バリアントもネゴシエートする", ""},
+	507: {"容量不足", ""},
+	508: {"ループを検出", ""},
+	510: {"拡張できない", ""},
+	511: {"ネットワーク認証が必要", ""},
+}
@@ -0,0 +1,67 @@
+package cmd
+
+// Vietnamese status code messages
+var viMessages = map[int]codeMessage{
+	100: {"Tiếp tục", ""},
+	101: {"Chuyển đổi giao thức", ""},
+	102: {"Đang xử lý", ""},
+	103: {"Gợi ý sớm", ""},
+	200: {"OK", "Yêu cầu đã thành công. Ý nghĩa của kết quả phụ thuộc vào phương thức HTTP."},
+	201: {"Đã tạo", "Yêu cầu đã thành công và một tài nguyên mới đã được tạo. Thường là phản hồi cho yêu cầu POST hoặc PUT."},
+	202: {"Đã chấp nhận", ""},
+	203: {"Thông tin không có thẩm quyền", ""},
+	204: {"Không có nội dung", "Yêu cầu đã thành công nhưng không có nội dung để gửi trong phản hồi. Các tiêu đề vẫn có thể hữu ích."},
+	205: {"Đặt lại nội dung", ""},
+	206: {"Nội dung một phần", ""},
+	207: {"Đa trạng thái", ""},
+	208: {"Đã báo cáo", ""},
+	226: {"Đã dùng IM", ""},
+	300: {"Nhiều lựa chọn", ""},
+	301: {"Đã chuyển vĩnh viễn", "URL của tài nguyên được yêu cầu đã thay đổi vĩnh viễn. URL mới được cung cấp trong phản hồi."},
+	302: {"Đã tìm thấy", "URI của tài nguyên được yêu cầu đã thay đổi tạm thời. Máy khách nên tiếp tục dùng URI cũ cho các yêu cầu sau."},
+	303: {"Xem tài nguyên khác", ""},
+	304: {"Không thay đổi", "Dùng cho bộ nhớ đệm: phản hồi chưa thay đổi nên máy khách có thể tiếp tục dùng phiên bản đã lưu."},
+	305: {"Dùng proxy", ""},
+	307: {"Chuyển hướng tạm thời", ""},
+	308: {"Chuyển hướng vĩnh viễn", ""},
+	400: {"Yêu cầu không hợp lệ", "Máy chủ không thể hoặc sẽ không xử lý yêu cầu do lỗi phía máy khách, ví dụ cú pháp yêu cầu sai."},
+	401: {"Chưa xác thực", "Máy khách phải xác thực để nhận được phản hồi được yêu cầu."},
+	402: {"Yêu cầu thanh toán", ""},
+	403: {"Bị cấm", "Máy khách không có quyền truy cập nội dung. Khác với 401, máy chủ đã biết danh tính của máy khách."},
+	404: {"Không tìm thấy", "Máy chủ không tìm thấy tài nguyên được yêu cầu. Trong API, điểm cuối có thể hợp lệ nhưng tài nguyên không tồn tại."},
+	405: {"Phương thức không được phép", ""},
+	406: {"Không chấp nhận được", ""},
+	407: {"Yêu cầu xác thực proxy", ""},
+	408: {"Hết thời gian chờ yêu cầu", ""},
+	409: {"Xung đột", "Yêu cầu xung đột với trạng thái hiện tại của máy chủ."},
+	410: {"Đã bị xóa", ""},
+	411: {"Yêu cầu độ dài", ""},
+	412: {"Điều kiện tiên quyết thất bại", ""},
+	413: {"Nội dung quá lớn", ""},
+	414: {"URI quá dài", ""},
+	415: {"Kiểu dữ liệu không được hỗ trợ", ""},
+	416: {"Phạm vi không thỏa mãn", ""},
+	417: {"Kỳ vọng thất bại", ""},
+	418: {"Tôi là ấm trà", ""},
+	421: {"Yêu cầu sai đích", ""},
+	422: {"Nội dung không thể xử lý", ""},
+	423: {"Đã bị khóa", ""},
+	424: {"Phụ thuộc thất bại", ""},
+	425: {"Quá sớm", ""},
+	426: {"Yêu cầu nâng cấp", ""},
+	428: {"Yêu cầu điều kiện tiên quyết", ""},
+	429: {"Quá nhiều yêu cầu", "Người dùng đã gửi quá nhiều yêu cầu trong một khoảng thời gian nhất định (giới hạn tốc độ)."},
+	431: {"Trường tiêu đề yêu cầu quá lớn", ""},
+	451: {"Không khả dụng vì lý do pháp lý", ""},
+	500: {"Lỗi máy chủ nội bộ", "Máy chủ gặp phải tình huống mà nó không biết cách xử lý."},
+	501: {"Chưa được triển khai", ""},
+	502: {"Cổng kết nối không hợp lệ", "Máy chủ, khi hoạt động như cổng kết nối, nhận được phản hồi không hợp lệ từ máy chủ phía sau."},
+	503: {"Dịch vụ không khả dụng", "Máy chủ chưa sẵn sàng xử lý yêu cầu, thường do đang bảo trì hoặc quá tải."},
+	504: {"Hết thời gian chờ cổng kết nối", "Máy chủ, khi hoạt động như cổng kết nối, không nhận được phản hồi kịp thời từ máy chủ phía sau."},
+	505: {"Phiên bản HTTP không được hỗ trợ", ""},
+	506: {"Biến thể cũng thương lượng", ""},
+	507: {"Không đủ dung lượng lưu trữ", ""},
+	508: {"Phát hiện vòng lặp", ""},
+	510: {"Không được mở rộng", ""},
+	511: {"Yêu cầu xác thực mạng", ""},
+}
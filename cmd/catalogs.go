@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// codeMessage is a translation of a status code's Description and Detail.
+// Empty fields fall back to English.
+type codeMessage struct {
+	Description string
+	Detail      string
+}
+
+// Message catalogs keyed by ISO 639-1 language code
+var messageCatalogs = map[string]map[int]codeMessage{
+	"vi": viMessages,
+	"es": esMessages,
+	"ja": jaMessages,
+	"de": deMessages,
+	"fr": frMessages,
+}
+
+// Locale used for status code descriptions; empty means English
+var displayLocale string
+
+// normalizeLocale reduces a locale such as vi_VN.UTF-8 or es-MX to its
+// language code. It returns "en" for English and the C/POSIX locales.
+func normalizeLocale(locale string) string {
+	locale = strings.ToLower(locale)
+	if i := strings.IndexAny(locale, "_-.@"); i >= 0 {
+		locale = locale[:i]
+	}
+	if locale == "c" || locale == "posix" {
+		return "en"
+	}
+	return locale
+}
+
+// isLocale reports whether a --lang value selects a description language
+func isLocale(value string) bool {
+	locale := normalizeLocale(value)
+	if locale == "en" {
+		return true
+	}
+	_, exists := messageCatalogs[locale]
+	return exists
+}
+
+// splitLangSelection splits a comma-separated --lang value into a description
+// locale and the remaining programming languages for constant names
+func splitLangSelection(selection string) (locale string, languages string) {
+	var rest []string
+	for _, value := range strings.Split(selection, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if isLocale(value) {
+			locale = normalizeLocale(value)
+		} else {
+			rest = append(rest, value)
+		}
+	}
+	return locale, strings.Join(rest, ",")
+}
+
+// selectLocale sets the description locale from an explicit value, falling
+// back to LC_ALL, LC_MESSAGES and LANG in that order
+func selectLocale(locale string) {
+	if locale == "" {
+		for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+			if value := os.Getenv(name); value != "" {
+				locale = value
+				break
+			}
+		}
+	}
+
+	displayLocale = ""
+	if locale := normalizeLocale(locale); messageCatalogs[locale] != nil {
+		displayLocale = locale
+	}
+}
+
+// localizedCodeInfo returns a status code's info translated into the display
+// locale, field by field
+func localizedCodeInfo(code int) HTTPCodeInfo {
+	info := httpCodesInfo[code]
+	message := messageCatalogs[displayLocale][code]
+	if message.Description != "" {
+		info.Description = message.Description
+	}
+	if message.Detail != "" {
+		info.Detail = message.Detail
+	}
+	return info
+}
+
+// Description language for commands without constant names (list, search)
+var descriptionLangFlag string
+
+// descriptionLangFlagUsage is the help text of the list and search --lang flag
+const descriptionLangFlagUsage = "language for descriptions: en, vi, es, ja, de, fr (default from LC_ALL or LANG)"
+
+// selectDescriptionLocale selects the locale from the list and search --lang
+// flag, reporting an unknown language
+func selectDescriptionLocale() bool {
+	if descriptionLangFlag != "" && !isLocale(descriptionLangFlag) {
+		displayErrorWithLipgloss(fmt.Sprintf("Invalid language %s. Use en, vi, es, ja, de or fr.", descriptionLangFlag))
+		return false
+	}
+	selectLocale(descriptionLangFlag)
+	return true
+}
@@ -0,0 +1,176 @@
+package cmd
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+// Run all tests with English descriptions, whatever the developer's locale
+func TestMain(m *testing.M) {
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		os.Unsetenv(name)
+	}
+	os.Exit(m.Run())
+}
+
+func TestMessageCatalogsStructure(t *testing.T) {
+	for locale, catalog := range messageCatalogs {
+		t.Run(locale, func(t *testing.T) {
+			for code, message := range catalog {
+				if _, exists := httpCodesInfo[code]; !exists {
+					t.Errorf("Catalog %s translates unknown status code %d", locale, code)
+				}
+				if message.Description == "" && message.Detail == "" {
+					t.Errorf("Catalog %s has an empty entry for %d", locale, code)
+				}
+			}
+
+			for code := range httpCodesInfo {
+				if catalog[code].Description == "" {
+					t.Errorf("Catalog %s is missing a description for %d", locale, code)
+				}
+			}
+		})
+	}
+}
+
+func TestNormalizeLocale(t *testing.T) {
+	tests := map[string]string{
+		"vi":          "vi",
+		"vi_VN.UTF-8": "vi",
+		"es-MX":       "es",
+		"DE_de":       "de",
+		"C":           "en",
+		"POSIX":       "en",
+		"C.UTF-8":     "en",
+	}
+
+	for input, want := range tests {
+		if got := normalizeLocale(input); got != want {
+			t.Errorf("normalizeLocale(%q) = %q, expected %q", input, got, want)
+		}
+	}
+}
+
+func TestSplitLangSelection(t *testing.T) {
+	tests := []struct {
+		selection     string
+		wantLocale    string
+		wantLanguages string
+	}{
+		{"", "", ""},
+		{"vi", "vi", ""},
+		{"go,python", "", "go,python"},
+		{"ja_JP,go", "ja", "go"},
+		{"all, fr", "fr", "all"},
+		{"en,rust", "en", "rust"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selection, func(t *testing.T) {
+			locale, languages := splitLangSelection(tt.selection)
+			if locale != tt.wantLocale || languages != tt.wantLanguages {
+				t.Errorf("splitLangSelection(%q) = %q, %q, expected %q, %q",
+					tt.selection, locale, languages, tt.wantLocale, tt.wantLanguages)
+			}
+		})
+	}
+}
+
+func TestSelectLocale(t *testing.T) {
+	defer selectLocale("en")
+
+	tests := []struct {
+		name   string
+		locale string
+		env    map[string]string
+		want   string
+	}{
+		{"explicit", "es", nil, "es"},
+		{"explicit wins over environment", "en", map[string]string{"LANG": "de_DE.UTF-8"}, ""},
+		{"LANG", "", map[string]string{"LANG": "fr_FR.UTF-8"}, "fr"},
+		{"LC_ALL wins over LANG", "", map[string]string{"LC_ALL": "ja_JP.UTF-8", "LANG": "fr_FR.UTF-8"}, "ja"},
+		{"unsupported locale", "", map[string]string{"LANG": "ko_KR.UTF-8"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			selectLocale(tt.locale)
+			if displayLocale != tt.want {
+				t.Errorf("selectLocale(%q) with %v selected %q, expected %q", tt.locale, tt.env, displayLocale, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalizedCodeInfo(t *testing.T) {
+	defer selectLocale("en")
+
+	selectLocale("vi")
+	info := localizedCodeInfo(404)
+	if info.Description != "Không tìm thấy" {
+		t.Errorf("Expected Vietnamese description for 404, got %q", info.Description)
+	}
+	if info.MDNLink != httpCodesInfo[404].MDNLink {
+		t.Errorf("Expected untranslated MDN link for 404, got %q", info.MDNLink)
+	}
+
+	// Untranslated fields fall back to English one by one
+	info = localizedCodeInfo(418)
+	if info.Description != "Tôi là ấm trà" {
+		t.Errorf("Expected Vietnamese description for 418, got %q", info.Description)
+	}
+	if info.Detail != httpCodesInfo[418].Detail {
+		t.Errorf("Expected English detail fallback for 418, got %q", info.Detail)
+	}
+
+	selectLocale("en")
+	if info := localizedCodeInfo(404); info != httpCodesInfo[404] {
+		t.Errorf("Expected English info for 404, got %+v", info)
+	}
+}
+
+func TestLookupCodeLocalized(t *testing.T) {
+	defer selectLocale("en")
+
+	for locale, want := range map[string]string{
+		"vi": "HTTP 404 Không tìm thấy",
+		"es": "HTTP 404 No encontrado",
+		"ja": "HTTP 404 未検出",
+		"de": "HTTP 404 Nicht gefunden",
+		"fr": "HTTP 404 Non trouvé",
+	} {
+		t.Run(locale, func(t *testing.T) {
+			selectLocale(locale)
+			stdout, _ := captureOutput(func() {
+				lookupCode(404)
+			})
+
+			if !strings.Contains(stdout, want) {
+				t.Errorf("Expected '%s' in output, got: %s", want, stdout)
+			}
+		})
+	}
+}
+
+func TestRootCommandLangSelection(t *testing.T) {
+	defer func() {
+		lookupLangFlag = ""
+		selectLocale("en")
+	}()
+
+	lookupLangFlag = "de,go"
+	stdout, _ := captureOutput(func() {
+		rootCmd.Run(rootCmd, []string{"503"})
+	})
+
+	for _, want := range []string{"Dienst nicht verfügbar", "http.StatusServiceUnavailable"} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("Expected '%s' in output, got: %s", want, stdout)
+		}
+	}
+}
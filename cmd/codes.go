@@ -194,8 +194,8 @@ var httpCodesInfo = map[int]HTTPCodeInfo{
 		MDNLink:     "https://developer.mozilla.org/en-US/docs/Web/HTTP/Status/412",
 	},
 	413: {
-		Description: "Payload Too Large",
-		Detail:      "Request entity is larger than limits defined by server; the server might close the connection or return an Retry-After header field.",
+		Description: "Content Too Large",
+		Detail:      "Request content is larger than limits defined by server; the server might close the connection or return an Retry-After header field.",
 		MDNLink:     "https://developer.mozilla.org/en-US/docs/Web/HTTP/Status/413",
 	},
 	414: {
@@ -229,7 +229,7 @@ var httpCodesInfo = map[int]HTTPCodeInfo{
 		MDNLink:     "https://developer.mozilla.org/en-US/docs/Web/HTTP/Status/421",
 	},
 	422: {
-		Description: "Unprocessable Entity",
+		Description: "Unprocessable Content",
 		Detail:      "The request was well-formed but was unable to be followed due to semantic errors. Commonly used with validation errors in APIs.",
 		MDNLink:     "https://developer.mozilla.org/en-US/docs/Web/HTTP/Status/422",
 	},
@@ -343,9 +343,12 @@ var httpCodes = func() map[int]string {
 
 // Helper function to look up a specific HTTP status code
 func lookupCode(code int) {
-	if info, exists := httpCodesInfo[code]; exists {
-		displayCodeWithLipgloss(code, info)
+	if _, exists := httpCodesInfo[code]; exists {
+		displayCodeWithLipgloss(code, localizedCodeInfo(code))
+	} else if isStatusCodeInRange(code) {
+		displayUnregisteredCodeWithLipgloss(code)
 	} else {
 		displayErrorWithLipgloss(fmt.Sprintf("HTTP status code %d not found", code))
+		displayInvalidCodeWithLipgloss(code)
 	}
 }
@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"math"
+	"sort"
+)
+
+// Fewest occurrences on both sides for a change to be tested; below that a
+// z-test is meaningless
+const minTestedCount = 5
+
+// rateChange compares the rate of a status code, or of errors at an
+// endpoint, between two logs
+type rateChange struct {
+	Code        int
+	Endpoint    string
+	BeforeCount int
+	BeforeTotal int
+	AfterCount  int
+	AfterTotal  int
+	// Two-sided p-value of the two-proportion z-test; 1 when not tested
+	PValue float64
+	// PValue corrected for the number of changes tested together, see
+	// adjustPValues
+	AdjustedPValue float64
+}
+
+// before returns the rate in the first log
+func (c rateChange) before() float64 {
+	return proportion(c.BeforeCount, c.BeforeTotal)
+}
+
+// after returns the rate in the second log
+func (c rateChange) after() float64 {
+	return proportion(c.AfterCount, c.AfterTotal)
+}
+
+// delta returns the change of the rate, as a fraction
+func (c rateChange) delta() float64 {
+	return c.after() - c.before()
+}
+
+// isNew reports whether the code never appeared in the first log
+func (c rateChange) isNew() bool {
+	return c.BeforeCount == 0 && c.AfterCount > 0
+}
+
+// significant reports whether the change is significant at level alpha,
+// after the correction for multiple comparisons
+func (c rateChange) significant(alpha float64) bool {
+	return c.AdjustedPValue < alpha
+}
+
+func proportion(count, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(count) / float64(total)
+}
+
+// twoProportionPValue returns the two-sided p-value of the pooled
+// two-proportion z-test of x1/n1 against x2/n2
+func twoProportionPValue(x1, n1, x2, n2 int) float64 {
+	if n1 == 0 || n2 == 0 || x1+x2 < minTestedCount {
+		return 1
+	}
+	pooled := float64(x1+x2) / float64(n1+n2)
+	se := math.Sqrt(pooled * (1 - pooled) * (1/float64(n1) + 1/float64(n2)))
+	if se == 0 {
+		return 1
+	}
+	z := (proportion(x2, n2) - proportion(x1, n1)) / se
+	return math.Erfc(math.Abs(z) / math.Sqrt2)
+}
+
+// newRateChange builds a change and tests it on its own
+func newRateChange(code int, endpoint string, beforeCount, beforeTotal, afterCount, afterTotal int) rateChange {
+	p := twoProportionPValue(beforeCount, beforeTotal, afterCount, afterTotal)
+	return rateChange{
+		Code:           code,
+		Endpoint:       endpoint,
+		BeforeCount:    beforeCount,
+		BeforeTotal:    beforeTotal,
+		AfterCount:     afterCount,
+		AfterTotal:     afterTotal,
+		PValue:         p,
+		AdjustedPValue: p,
+	}
+}
+
+// adjustPValues applies the Holm-Bonferroni correction across every tested
+// change of the groups, so that testing many codes and endpoints does not
+// inflate false positives. Untested changes keep a p-value of 1.
+func adjustPValues(groups ...[]rateChange) {
+	var tested []*rateChange
+	for _, changes := range groups {
+		for i := range changes {
+			changes[i].AdjustedPValue = changes[i].PValue
+			if changes[i].PValue < 1 {
+				tested = append(tested, &changes[i])
+			}
+		}
+	}
+	sort.SliceStable(tested, func(i, j int) bool { return tested[i].PValue < tested[j].PValue })
+
+	previous := 0.0
+	for i, change := range tested {
+		adjusted := math.Min(1, float64(len(tested)-i)*change.PValue)
+		// Adjusted p-values never decrease with the raw ones
+		adjusted = math.Max(adjusted, previous)
+		change.AdjustedPValue, previous = adjusted, adjusted
+	}
+}
+
+// testedCount returns the number of changes tested across the groups
+func testedCount(groups ...[]rateChange) int {
+	count := 0
+	for _, changes := range groups {
+		for _, change := range changes {
+			if change.PValue < 1 {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// compareCodes compares the rate of every status code seen in either log
+func compareCodes(before, after *statusStats) []rateChange {
+	codes := make(map[int]bool)
+	for code := range before.Counts {
+		codes[code] = true
+	}
+	for code := range after.Counts {
+		codes[code] = true
+	}
+
+	var changes []rateChange
+	for code := range codes {
+		changes = append(changes, newRateChange(code, "", before.Counts[code], before.Total, after.Counts[code], after.Total))
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Code < changes[j].Code })
+	return changes
+}
+
+// compareEndpoints compares the error rate (4xx and 5xx) of every endpoint
+// seen in both logs, most significant first
+func compareEndpoints(before, after *statusStats) []rateChange {
+	var changes []rateChange
+	for key, afterCounts := range after.Endpoints {
+		beforeCounts, ok := before.Endpoints[key]
+		if !ok {
+			continue
+		}
+		b, a := endpointCounts{Counts: beforeCounts}, endpointCounts{Counts: afterCounts}
+		changes = append(changes, newRateChange(0, key,
+			b.classCount(4)+b.classCount(5), b.total(),
+			a.classCount(4)+a.classCount(5), a.total()))
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].PValue != changes[j].PValue {
+			return changes[i].PValue < changes[j].PValue
+		}
+		return changes[i].Endpoint < changes[j].Endpoint
+	})
+	return changes
+}
+
+// regressions returns the changes that fail a deploy gate: a significant
+// rise of a 5xx code or of an endpoint's error rate, or a new 5xx code seen
+// at least minNew times
+func regressions(codes, endpoints []rateChange, alpha float64, minNew int) []rateChange {
+	var failed []rateChange
+	for _, change := range codes {
+		if change.Code/100 != 5 {
+			continue
+		}
+		if (change.isNew() && change.AfterCount >= minNew) || (change.significant(alpha) && change.delta() > 0) {
+			failed = append(failed, change)
+		}
+	}
+	for _, change := range endpoints {
+		if change.significant(alpha) && change.delta() > 0 {
+			failed = append(failed, change)
+		}
+	}
+	return failed
+}
@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config file set with --config
+var cfgFile string
+
+// Name of the config file in the home directory
+const defaultConfigName = ".httpcode.yaml"
+
+// appConfig is the content of the config file
+type appConfig struct {
+	LogFormats    []logFormatConfig    `yaml:"log_formats"`
+	PathTemplates []pathTemplateConfig `yaml:"path_templates"`
+}
+
+// logFormatConfig is a user-defined log format: a regular expression with
+// named groups, or the field paths of JSON lines
+type logFormatConfig struct {
+	Name       string            `yaml:"name"`
+	Pattern    string            `yaml:"pattern"`
+	JSON       map[string]string `yaml:"json"`
+	TimeLayout string            `yaml:"time_layout"`
+}
+
+// pathTemplateConfig is a user-defined endpoint template: paths matching the
+// regular expression are rewritten with the template, which may use $1
+type pathTemplateConfig struct {
+	Match    string `yaml:"match"`
+	Template string `yaml:"template"`
+}
+
+// loadConfig reads the config file. A missing default config file is not an
+// error, a missing file given with --config is.
+func loadConfig() (appConfig, error) {
+	var config appConfig
+
+	path := cfgFile
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return config, nil
+		}
+		path = filepath.Join(home, defaultConfigName)
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && cfgFile == "" {
+		return config, nil
+	}
+	if err != nil {
+		return config, fmt.Errorf("cannot read config file %s: %v", path, err)
+	}
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return config, fmt.Errorf("invalid config file %s: %v", path, err)
+	}
+	return config, nil
+}
+
+// loadUserLogFormats builds the log formats defined in the config file
+func loadUserLogFormats() ([]logFormat, error) {
+	config, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	var formats []logFormat
+	for _, c := range config.LogFormats {
+		format, err := c.build()
+		if err != nil {
+			return nil, err
+		}
+		formats = append(formats, format)
+	}
+	return formats, nil
+}
+
+// build validates a user-defined log format and builds its parser
+func (c logFormatConfig) build() (logFormat, error) {
+	if c.Name == "" {
+		return logFormat{}, fmt.Errorf("log format without a name in the config file")
+	}
+	description := fmt.Sprintf("%s (config file)", c.Name)
+
+	switch {
+	case c.Pattern != "" && c.JSON != nil:
+		return logFormat{}, fmt.Errorf("log format %s: use either pattern or json, not both", c.Name)
+	case c.Pattern != "":
+		re, err := regexp.Compile(c.Pattern)
+		if err != nil {
+			return logFormat{}, fmt.Errorf("log format %s: %v", c.Name, err)
+		}
+		if re.SubexpIndex("status") < 0 {
+			return logFormat{}, fmt.Errorf("log format %s: pattern needs a (?P<status>...) group", c.Name)
+		}
+		return newRegexLogFormat(c.Name, description, re, c.TimeLayout), nil
+	case c.JSON != nil:
+		if c.JSON["status"] == "" {
+			return logFormat{}, fmt.Errorf("log format %s: json needs a status field path", c.Name)
+		}
+		fields := jsonLogFields{Status: []string{c.JSON["status"]}}
+		for key, paths := range map[string]*[]string{
+			"method":          &fields.Method,
+			"path":            &fields.Path,
+			"time":            &fields.Time,
+			"upstream_status": &fields.UpstreamStatus,
+			"upstream_length": &fields.UpstreamLength,
+			"response_flags":  &fields.Flags,
+		} {
+			if c.JSON[key] != "" {
+				*paths = []string{c.JSON[key]}
+			}
+		}
+		return jsonLogFormat(c.Name, description, fields, c.TimeLayout, false), nil
+	default:
+		return logFormat{}, fmt.Errorf("log format %s: needs a pattern or json field paths", c.Name)
+	}
+}
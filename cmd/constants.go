@@ -0,0 +1,489 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// codeLanguage describes how a programming language names HTTP status codes
+type codeLanguage struct {
+	Key       string
+	Name      string
+	Qualifier string
+	Constants map[int]string
+	Aliases   map[string]int
+	Fallback  string
+}
+
+// qualified returns the idiomatic expression for a status code in the language
+func (l codeLanguage) qualified(code int) string {
+	if name, exists := l.Constants[code]; exists {
+		return l.Qualifier + name
+	}
+	return fmt.Sprintf(l.Fallback, code)
+}
+
+// Languages in display order
+var codeLanguages = []codeLanguage{
+	{
+		Key:       "go",
+		Name:      "Go",
+		Qualifier: "http.",
+		Constants: goStatusConstants,
+		Fallback:  "%d",
+	},
+	{
+		Key:       "python",
+		Name:      "Python",
+		Qualifier: "HTTPStatus.",
+		Constants: pythonStatusConstants,
+		// Names introduced in Python 3.13 alongside the RFC 9110 phrases
+		Aliases: map[string]int{
+			"CONTENT_TOO_LARGE":     413,
+			"URI_TOO_LONG":          414,
+			"RANGE_NOT_SATISFIABLE": 416,
+			"UNPROCESSABLE_CONTENT": 422,
+		},
+		Fallback: "HTTPStatus(%d)",
+	},
+	{
+		Key:       "java",
+		Name:      "Java (Spring)",
+		Qualifier: "HttpStatus.",
+		Constants: javaStatusConstants,
+		Fallback:  "HttpStatusCode.valueOf(%d)",
+	},
+	{
+		Key:      "node",
+		Name:     "Node.js",
+		Fallback: "http.STATUS_CODES[%d]",
+	},
+	{
+		Key:       "rust",
+		Name:      "Rust (http)",
+		Qualifier: "StatusCode::",
+		Constants: rustStatusConstants,
+		Fallback:  "StatusCode::from_u16(%d)",
+	},
+	{
+		Key:       "csharp",
+		Name:      "C#",
+		Qualifier: "HttpStatusCode.",
+		Constants: csharpStatusConstants,
+		// Older synonyms that share a value with the primary member
+		Aliases: map[string]int{
+			"Ambiguous":            300,
+			"Moved":                301,
+			"Redirect":             302,
+			"RedirectMethod":       303,
+			"RedirectKeepVerb":     307,
+			"UnprocessableContent": 422,
+		},
+		Fallback: "(HttpStatusCode)%d",
+	},
+}
+
+// Go net/http constant names
+var goStatusConstants = map[int]string{
+	100: "StatusContinue",
+	101: "StatusSwitchingProtocols",
+	102: "StatusProcessing",
+	103: "StatusEarlyHints",
+	200: "StatusOK",
+	201: "StatusCreated",
+	202: "StatusAccepted",
+	203: "StatusNonAuthoritativeInfo",
+	204: "StatusNoContent",
+	205: "StatusResetContent",
+	206: "StatusPartialContent",
+	207: "StatusMultiStatus",
+	208: "StatusAlreadyReported",
+	226: "StatusIMUsed",
+	300: "StatusMultipleChoices",
+	301: "StatusMovedPermanently",
+	302: "StatusFound",
+	303: "StatusSeeOther",
+	304: "StatusNotModified",
+	305: "StatusUseProxy",
+	307: "StatusTemporaryRedirect",
+	308: "StatusPermanentRedirect",
+	400: "StatusBadRequest",
+	401: "StatusUnauthorized",
+	402: "StatusPaymentRequired",
+	403: "StatusForbidden",
+	404: "StatusNotFound",
+	405: "StatusMethodNotAllowed",
+	406: "StatusNotAcceptable",
+	407: "StatusProxyAuthRequired",
+	408: "StatusRequestTimeout",
+	409: "StatusConflict",
+	410: "StatusGone",
+	411: "StatusLengthRequired",
+	412: "StatusPreconditionFailed",
+	413: "StatusRequestEntityTooLarge",
+	414: "StatusRequestURITooLong",
+	415: "StatusUnsupportedMediaType",
+	416: "StatusRequestedRangeNotSatisfiable",
+	417: "StatusExpectationFailed",
+	418: "StatusTeapot",
+	421: "StatusMisdirectedRequest",
+	422: "StatusUnprocessableEntity",
+	423: "StatusLocked",
+	424: "StatusFailedDependency",
+	425: "StatusTooEarly",
+	426: "StatusUpgradeRequired",
+	428: "StatusPreconditionRequired",
+	429: "StatusTooManyRequests",
+	431: "StatusRequestHeaderFieldsTooLarge",
+	451: "StatusUnavailableForLegalReasons",
+	500: "StatusInternalServerError",
+	501: "StatusNotImplemented",
+	502: "StatusBadGateway",
+	503: "StatusServiceUnavailable",
+	504: "StatusGatewayTimeout",
+	505: "StatusHTTPVersionNotSupported",
+	506: "StatusVariantAlsoNegotiates",
+	507: "StatusInsufficientStorage",
+	508: "StatusLoopDetected",
+	510: "StatusNotExtended",
+	511: "StatusNetworkAuthenticationRequired",
+}
+
+// Python http.HTTPStatus member names
+var pythonStatusConstants = map[int]string{
+	100: "CONTINUE",
+	101: "SWITCHING_PROTOCOLS",
+	102: "PROCESSING",
+	103: "EARLY_HINTS",
+	200: "OK",
+	201: "CREATED",
+	202: "ACCEPTED",
+	203: "NON_AUTHORITATIVE_INFORMATION",
+	204: "NO_CONTENT",
+	205: "RESET_CONTENT",
+	206: "PARTIAL_CONTENT",
+	207: "MULTI_STATUS",
+	208: "ALREADY_REPORTED",
+	226: "IM_USED",
+	300: "MULTIPLE_CHOICES",
+	301: "MOVED_PERMANENTLY",
+	302: "FOUND",
+	303: "SEE_OTHER",
+	304: "NOT_MODIFIED",
+	305: "USE_PROXY",
+	307: "TEMPORARY_REDIRECT",
+	308: "PERMANENT_REDIRECT",
+	400: "BAD_REQUEST",
+	401: "UNAUTHORIZED",
+	402: "PAYMENT_REQUIRED",
+	403: "FORBIDDEN",
+	404: "NOT_FOUND",
+	405: "METHOD_NOT_ALLOWED",
+	406: "NOT_ACCEPTABLE",
+	407: "PROXY_AUTHENTICATION_REQUIRED",
+	408: "REQUEST_TIMEOUT",
+	409: "CONFLICT",
+	410: "GONE",
+	411: "LENGTH_REQUIRED",
+	412: "PRECONDITION_FAILED",
+	413: "REQUEST_ENTITY_TOO_LARGE",
+	414: "REQUEST_URI_TOO_LONG",
+	415: "UNSUPPORTED_MEDIA_TYPE",
+	416: "REQUESTED_RANGE_NOT_SATISFIABLE",
+	417: "EXPECTATION_FAILED",
+	418: "IM_A_TEAPOT",
+	421: "MISDIRECTED_REQUEST",
+	422: "UNPROCESSABLE_ENTITY",
+	423: "LOCKED",
+	424: "FAILED_DEPENDENCY",
+	425: "TOO_EARLY",
+	426: "UPGRADE_REQUIRED",
+	428: "PRECONDITION_REQUIRED",
+	429: "TOO_MANY_REQUESTS",
+	431: "REQUEST_HEADER_FIELDS_TOO_LARGE",
+	451: "UNAVAILABLE_FOR_LEGAL_REASONS",
+	500: "INTERNAL_SERVER_ERROR",
+	501: "NOT_IMPLEMENTED",
+	502: "BAD_GATEWAY",
+	503: "SERVICE_UNAVAILABLE",
+	504: "GATEWAY_TIMEOUT",
+	505: "HTTP_VERSION_NOT_SUPPORTED",
+	506: "VARIANT_ALSO_NEGOTIATES",
+	507: "INSUFFICIENT_STORAGE",
+	508: "LOOP_DETECTED",
+	510: "NOT_EXTENDED",
+	511: "NETWORK_AUTHENTICATION_REQUIRED",
+}
+
+// Spring org.springframework.http.HttpStatus enum names
+var javaStatusConstants = map[int]string{
+	100: "CONTINUE",
+	101: "SWITCHING_PROTOCOLS",
+	102: "PROCESSING",
+	103: "EARLY_HINTS",
+	200: "OK",
+	201: "CREATED",
+	202: "ACCEPTED",
+	203: "NON_AUTHORITATIVE_INFORMATION",
+	204: "NO_CONTENT",
+	205: "RESET_CONTENT",
+	206: "PARTIAL_CONTENT",
+	207: "MULTI_STATUS",
+	208: "ALREADY_REPORTED",
+	226: "IM_USED",
+	300: "MULTIPLE_CHOICES",
+	301: "MOVED_PERMANENTLY",
+	302: "FOUND",
+	303: "SEE_OTHER",
+	304: "NOT_MODIFIED",
+	305: "USE_PROXY",
+	307: "TEMPORARY_REDIRECT",
+	308: "PERMANENT_REDIRECT",
+	400: "BAD_REQUEST",
+	401: "UNAUTHORIZED",
+	402: "PAYMENT_REQUIRED",
+	403: "FORBIDDEN",
+	404: "NOT_FOUND",
+	405: "METHOD_NOT_ALLOWED",
+	406: "NOT_ACCEPTABLE",
+	407: "PROXY_AUTHENTICATION_REQUIRED",
+	408: "REQUEST_TIMEOUT",
+	409: "CONFLICT",
+	410: "GONE",
+	411: "LENGTH_REQUIRED",
+	412: "PRECONDITION_FAILED",
+	413: "PAYLOAD_TOO_LARGE",
+	414: "URI_TOO_LONG",
+	415: "UNSUPPORTED_MEDIA_TYPE",
+	416: "REQUESTED_RANGE_NOT_SATISFIABLE",
+	417: "EXPECTATION_FAILED",
+	418: "I_AM_A_TEAPOT",
+	422: "UNPROCESSABLE_ENTITY",
+	423: "LOCKED",
+	424: "FAILED_DEPENDENCY",
+	425: "TOO_EARLY",
+	426: "UPGRADE_REQUIRED",
+	428: "PRECONDITION_REQUIRED",
+	429: "TOO_MANY_REQUESTS",
+	431: "REQUEST_HEADER_FIELDS_TOO_LARGE",
+	451: "UNAVAILABLE_FOR_LEGAL_REASONS",
+	500: "INTERNAL_SERVER_ERROR",
+	501: "NOT_IMPLEMENTED",
+	502: "BAD_GATEWAY",
+	503: "SERVICE_UNAVAILABLE",
+	504: "GATEWAY_TIMEOUT",
+	505: "HTTP_VERSION_NOT_SUPPORTED",
+	506: "VARIANT_ALSO_NEGOTIATES",
+	507: "INSUFFICIENT_STORAGE",
+	508: "LOOP_DETECTED",
+	510: "NOT_EXTENDED",
+	511: "NETWORK_AUTHENTICATION_REQUIRED",
+}
+
+// Rust http::StatusCode associated constant names
+var rustStatusConstants = map[int]string{
+	100: "CONTINUE",
+	101: "SWITCHING_PROTOCOLS",
+	102: "PROCESSING",
+	200: "OK",
+	201: "CREATED",
+	202: "ACCEPTED",
+	203: "NON_AUTHORITATIVE_INFORMATION",
+	204: "NO_CONTENT",
+	205: "RESET_CONTENT",
+	206: "PARTIAL_CONTENT",
+	207: "MULTI_STATUS",
+	208: "ALREADY_REPORTED",
+	226: "IM_USED",
+	300: "MULTIPLE_CHOICES",
+	301: "MOVED_PERMANENTLY",
+	302: "FOUND",
+	303: "SEE_OTHER",
+	304: "NOT_MODIFIED",
+	305: "USE_PROXY",
+	307: "TEMPORARY_REDIRECT",
+	308: "PERMANENT_REDIRECT",
+	400: "BAD_REQUEST",
+	401: "UNAUTHORIZED",
+	402: "PAYMENT_REQUIRED",
+	403: "FORBIDDEN",
+	404: "NOT_FOUND",
+	405: "METHOD_NOT_ALLOWED",
+	406: "NOT_ACCEPTABLE",
+	407: "PROXY_AUTHENTICATION_REQUIRED",
+	408: "REQUEST_TIMEOUT",
+	409: "CONFLICT",
+	410: "GONE",
+	411: "LENGTH_REQUIRED",
+	412: "PRECONDITION_FAILED",
+	413: "PAYLOAD_TOO_LARGE",
+	414: "URI_TOO_LONG",
+	415: "UNSUPPORTED_MEDIA_TYPE",
+	416: "RANGE_NOT_SATISFIABLE",
+	417: "EXPECTATION_FAILED",
+	418: "IM_A_TEAPOT",
+	421: "MISDIRECTED_REQUEST",
+	422: "UNPROCESSABLE_ENTITY",
+	423: "LOCKED",
+	424: "FAILED_DEPENDENCY",
+	426: "UPGRADE_REQUIRED",
+	428: "PRECONDITION_REQUIRED",
+	429: "TOO_MANY_REQUESTS",
+	431: "REQUEST_HEADER_FIELDS_TOO_LARGE",
+	451: "UNAVAILABLE_FOR_LEGAL_REASONS",
+	500: "INTERNAL_SERVER_ERROR",
+	501: "NOT_IMPLEMENTED",
+	502: "BAD_GATEWAY",
+	503: "SERVICE_UNAVAILABLE",
+	504: "GATEWAY_TIMEOUT",
+	505: "HTTP_VERSION_NOT_SUPPORTED",
+	506: "VARIANT_ALSO_NEGOTIATES",
+	507: "INSUFFICIENT_STORAGE",
+	508: "LOOP_DETECTED",
+	510: "NOT_EXTENDED",
+	511: "NETWORK_AUTHENTICATION_REQUIRED",
+}
+
+// C# System.Net.HttpStatusCode enum member names
+var csharpStatusConstants = map[int]string{
+	100: "Continue",
+	101: "SwitchingProtocols",
+	102: "Processing",
+	103: "EarlyHints",
+	200: "OK",
+	201: "Created",
+	202: "Accepted",
+	203: "NonAuthoritativeInformation",
+	204: "NoContent",
+	205: "ResetContent",
+	206: "PartialContent",
+	207: "MultiStatus",
+	208: "AlreadyReported",
+	226: "IMUsed",
+	300: "MultipleChoices",
+	301: "MovedPermanently",
+	302: "Found",
+	303: "SeeOther",
+	304: "NotModified",
+	305: "UseProxy",
+	307: "TemporaryRedirect",
+	308: "PermanentRedirect",
+	400: "BadRequest",
+	401: "Unauthorized",
+	402: "PaymentRequired",
+	403: "Forbidden",
+	404: "NotFound",
+	405: "MethodNotAllowed",
+	406: "NotAcceptable",
+	407: "ProxyAuthenticationRequired",
+	408: "RequestTimeout",
+	409: "Conflict",
+	410: "Gone",
+	411: "LengthRequired",
+	412: "PreconditionFailed",
+	413: "RequestEntityTooLarge",
+	414: "RequestUriTooLong",
+	415: "UnsupportedMediaType",
+	416: "RequestedRangeNotSatisfiable",
+	417: "ExpectationFailed",
+	421: "MisdirectedRequest",
+	422: "UnprocessableEntity",
+	423: "Locked",
+	424: "FailedDependency",
+	426: "UpgradeRequired",
+	428: "PreconditionRequired",
+	429: "TooManyRequests",
+	431: "RequestHeaderFieldsTooLarge",
+	451: "UnavailableForLegalReasons",
+	500: "InternalServerError",
+	501: "NotImplemented",
+	502: "BadGateway",
+	503: "ServiceUnavailable",
+	504: "GatewayTimeout",
+	505: "HttpVersionNotSupported",
+	506: "VariantAlsoNegotiates",
+	507: "InsufficientStorage",
+	508: "LoopDetected",
+	510: "NotExtended",
+	511: "NetworkAuthenticationRequired",
+}
+
+// Reverse index from constant names (bare or qualified) to status codes
+var statusConstantIndex = func() map[string]int {
+	index := make(map[string]int)
+	for _, lang := range codeLanguages {
+		for code, name := range lang.Constants {
+			index[name] = code
+			index[lang.Qualifier+name] = code
+		}
+		for name, code := range lang.Aliases {
+			index[name] = code
+			index[lang.Qualifier+name] = code
+		}
+	}
+	return index
+}()
+
+// resolveStatusConstant returns the status code for a language constant name
+func resolveStatusConstant(name string) (int, bool) {
+	code, exists := statusConstantIndex[strings.TrimSpace(name)]
+	return code, exists
+}
+
+// findCodeLanguages returns the languages selected by a comma-separated list or "all"
+func findCodeLanguages(selection string) ([]codeLanguage, error) {
+	if strings.EqualFold(selection, "all") {
+		return codeLanguages, nil
+	}
+
+	var selected []codeLanguage
+	for _, key := range strings.Split(selection, ",") {
+		key = strings.ToLower(strings.TrimSpace(key))
+		found := false
+		for _, lang := range codeLanguages {
+			if lang.Key == key {
+				selected = append(selected, lang)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown language %s. Use %s or all", key, strings.Join(codeLanguageKeys(), ", "))
+		}
+	}
+	return selected, nil
+}
+
+// codeLanguageKeys returns the supported language keys in alphabetical order
+func codeLanguageKeys() []string {
+	var keys []string
+	for _, lang := range codeLanguages {
+		keys = append(keys, lang.Key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// displayStatusConstants displays the constant names of a status code in the selected languages
+func displayStatusConstants(code int, selection string) {
+	languages, err := findCodeLanguages(selection)
+	if err != nil {
+		displayErrorWithLipgloss(err.Error())
+		return
+	}
+
+	fmt.Println("💻 Constants:")
+	for _, lang := range languages {
+		expression := lang.qualified(code)
+		style := lipgloss.NewStyle().Foreground(getStatusCodeColor(code))
+		if _, exists := lang.Constants[code]; !exists && lang.Constants != nil {
+			// No named constant exists in this language
+			style = lipgloss.NewStyle().Foreground(mutedColor)
+			expression += "  (no named constant)"
+		}
+		fmt.Printf("   %-14s %s\n", lang.Name, style.Render(expression))
+	}
+}
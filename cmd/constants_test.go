@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestStatusConstantsCoverage(t *testing.T) {
+	for code := range httpCodesInfo {
+		t.Run(fmt.Sprintf("code_%d_constants", code), func(t *testing.T) {
+			if _, exists := goStatusConstants[code]; !exists {
+				t.Errorf("HTTP code %d has no Go constant", code)
+			}
+			if _, exists := pythonStatusConstants[code]; !exists {
+				t.Errorf("HTTP code %d has no Python constant", code)
+			}
+		})
+	}
+
+	for _, lang := range codeLanguages {
+		for code := range lang.Constants {
+			if _, exists := httpCodesInfo[code]; !exists {
+				t.Errorf("%s constant for unknown HTTP code %d", lang.Name, code)
+			}
+		}
+	}
+}
+
+func TestStatusConstantNamesAreUnambiguous(t *testing.T) {
+	seen := make(map[string]int)
+	for _, lang := range codeLanguages {
+		names := make(map[string]int)
+		for code, name := range lang.Constants {
+			names[name] = code
+		}
+		for name, code := range lang.Aliases {
+			names[name] = code
+		}
+		for name, code := range names {
+			if other, exists := seen[name]; exists && other != code {
+				t.Errorf("Constant name %s refers to both %d and %d", name, other, code)
+			}
+			seen[name] = code
+		}
+	}
+}
+
+func TestResolveStatusConstant(t *testing.T) {
+	tests := []struct {
+		name     string
+		constant string
+		wantCode int
+		wantOK   bool
+	}{
+		{"go bare", "StatusTeapot", 418, true},
+		{"go qualified", "http.StatusTooManyRequests", 429, true},
+		{"python", "HTTPStatus.TOO_MANY_REQUESTS", 429, true},
+		{"python 3.13 alias", "CONTENT_TOO_LARGE", 413, true},
+		{"java", "I_AM_A_TEAPOT", 418, true},
+		{"rust", "StatusCode::PAYLOAD_TOO_LARGE", 413, true},
+		{"csharp", "HttpStatusCode.TooManyRequests", 429, true},
+		{"csharp alias", "RedirectKeepVerb", 307, true},
+		{"unknown", "StatusCoffee", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, ok := resolveStatusConstant(tt.constant)
+			if ok != tt.wantOK || code != tt.wantCode {
+				t.Errorf("resolveStatusConstant(%q) = %d, %v, want %d, %v", tt.constant, code, ok, tt.wantCode, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestDisplayStatusConstants(t *testing.T) {
+	tests := []struct {
+		name            string
+		code            int
+		lang            string
+		wantContains    []string
+		wantNotContains []string
+	}{
+		{
+			name: "all languages",
+			code: 429,
+			lang: "all",
+			wantContains: []string{
+				"http.StatusTooManyRequests",
+				"HTTPStatus.TOO_MANY_REQUESTS",
+				"HttpStatus.TOO_MANY_REQUESTS",
+				"http.STATUS_CODES[429]",
+				"StatusCode::TOO_MANY_REQUESTS",
+				"HttpStatusCode.TooManyRequests",
+			},
+		},
+		{
+			name:            "selected languages",
+			code:            404,
+			lang:            "go, csharp",
+			wantContains:    []string{"http.StatusNotFound", "HttpStatusCode.NotFound"},
+			wantNotContains: []string{"HTTPStatus.NOT_FOUND"},
+		},
+		{
+			name:         "missing constant falls back",
+			code:         418,
+			lang:         "csharp",
+			wantContains: []string{"(HttpStatusCode)418", "no named constant"},
+		},
+		{
+			name:         "unknown language",
+			code:         200,
+			lang:         "cobol",
+			wantContains: []string{"unknown language cobol"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout, _ := captureOutput(func() {
+				displayStatusConstants(tt.code, tt.lang)
+			})
+
+			for _, want := range tt.wantContains {
+				if !strings.Contains(stdout, want) {
+					t.Errorf("Expected '%s' in output, got: %s", want, stdout)
+				}
+			}
+
+			for _, unwanted := range tt.wantNotContains {
+				if strings.Contains(stdout, unwanted) {
+					t.Errorf("Did not expect '%s' in output, got: %s", unwanted, stdout)
+				}
+			}
+		})
+	}
+}
+
+func TestRootCommandResolvesConstant(t *testing.T) {
+	stdout, _ := captureOutput(func() {
+		rootCmd.Run(rootCmd, []string{"HTTPStatus.IM_A_TEAPOT"})
+	})
+
+	for _, want := range []string{"HTTP 418", "Constants:", "http.StatusTeapot"} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("Expected '%s' in output, got: %s", want, stdout)
+		}
+	}
+}
@@ -0,0 +1,287 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+var (
+	diffSplit     string
+	diffWindow    time.Duration
+	diffAlpha     float64
+	diffFail      bool
+	diffEndpoints int
+	diffMinNew    int
+)
+
+// Layouts accepted by --split, besides RFC 3339
+var splitTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+}
+
+// statsDiffCmd represents the stats diff command
+var statsDiffCmd = &cobra.Command{
+	Use:   "diff <before> <after>",
+	Short: "Compare the status codes of two logs or two time ranges",
+	Long: `Compare the status code distribution of two access logs, e.g. baseline and
+canary, or of the requests before and after --split in the same logs.
+
+Every code's rate is compared with a two-proportion z-test, and so is the
+error rate (4xx and 5xx) of every endpoint seen on both sides. The p-values
+are adjusted with the Holm-Bonferroni method across all the tests, so that
+comparing many endpoints does not add false positives; changes with an
+adjusted p-value below --alpha are significant. Codes that never appeared
+before are flagged as new. Codes and endpoints with fewer than 5 occurrences
+are not tested.
+
+With --fail the command exits with status 1 on a regression, i.e. a
+significant rise of a 5xx code or of an endpoint's error rate, or a new 5xx
+code seen at least --min-new times, so it can gate a deploy:
+  httpcode stats diff baseline.log canary.log --fail
+  httpcode stats diff --split "2026-10-19 10:30" --window 30m access.log`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		before, after, labels, err := readDiffStats(args)
+		if err != nil {
+			displayErrorWithLipgloss(err.Error())
+			os.Exit(1)
+		}
+		if before.Total == 0 || after.Total == 0 {
+			displayErrorWithLipgloss(fmt.Sprintf("No requests to compare (%d before, %d after)", before.Total, after.Total))
+			os.Exit(1)
+		}
+
+		codes := compareCodes(before, after)
+		endpoints := compareEndpoints(before, after)
+		adjustPValues(codes, endpoints)
+		failed := regressions(codes, endpoints, diffAlpha, diffMinNew)
+		displayStatusDiffWithLipgloss(labels, before, after, codes, endpoints, failed)
+		if diffFail && len(failed) > 0 {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	statsDiffCmd.Flags().StringVar(&diffSplit, "split", "", "compare the requests before and after this time (e.g. 2026-10-19T10:30:00Z)")
+	statsDiffCmd.Flags().DurationVar(&diffWindow, "window", 0, "with --split, only compare this long on each side (e.g. 30m)")
+	statsDiffCmd.Flags().Float64Var(&diffAlpha, "alpha", 0.01, "significance level of the tests")
+	statsDiffCmd.Flags().BoolVar(&diffFail, "fail", false, "exit with status 1 on a significant regression")
+	statsDiffCmd.Flags().IntVar(&diffEndpoints, "endpoints", 10, "number of endpoint changes shown")
+	statsDiffCmd.Flags().IntVar(&diffMinNew, "min-new", minTestedCount, "fewest requests for a new 5xx code to be a regression")
+	statsDiffCmd.Flags().StringVarP(&logFormatFlag, "format", "f", "auto", logFormatFlagUsage)
+	statsDiffCmd.Flags().BoolVar(&logRotated, "rotated", false, "also read the rotated files of each log (access.log.1, access.log.2.gz, ...)")
+	statsCmd.AddCommand(statsDiffCmd)
+}
+
+// parseSplitTime parses the --split time, in local time unless it has a zone
+func parseSplitTime(value string) (time.Time, error) {
+	for _, layout := range splitTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid split time %s. Use RFC 3339 or 2006-01-02 15:04", value)
+}
+
+// readDiffStats reads the two sides of a comparison: two logs, or the
+// requests of the logs before and after --split
+func readDiffStats(args []string) (*statusStats, *statusStats, [2]string, error) {
+	var labels [2]string
+	templater, err := loadPathTemplater()
+	if err != nil {
+		return nil, nil, labels, err
+	}
+	side := func(paths []string, filter func(logRecord) bool) (*statusStats, error) {
+		return collectLogFiles(paths, os.Stdin, func(stats *statusStats) {
+			stats.Templater = templater
+			stats.Filter = filter
+		})
+	}
+
+	if diffSplit == "" {
+		if len(args) != 2 {
+			return nil, nil, labels, fmt.Errorf("pass a before and an after log, or one log with --split")
+		}
+		if args[0] == "-" && args[1] == "-" {
+			return nil, nil, labels, fmt.Errorf("standard input can only be one side of the comparison")
+		}
+		before, err := side(args[:1], nil)
+		if err != nil {
+			return nil, nil, labels, err
+		}
+		after, err := side(args[1:], nil)
+		if err != nil {
+			return nil, nil, labels, err
+		}
+		return before, after, [2]string{args[0], args[1]}, nil
+	}
+
+	split, err := parseSplitTime(diffSplit)
+	if err != nil {
+		return nil, nil, labels, err
+	}
+	if diffWindow < 0 {
+		return nil, nil, labels, fmt.Errorf("invalid window %s. Use a positive duration, e.g. 30m", diffWindow)
+	}
+	for _, arg := range args {
+		if arg == "-" {
+			return nil, nil, labels, fmt.Errorf("--split reads the logs twice and cannot read standard input")
+		}
+	}
+
+	before, err := side(args, func(r logRecord) bool {
+		return !r.Time.IsZero() && r.Time.Before(split) && (diffWindow == 0 || !r.Time.Before(split.Add(-diffWindow)))
+	})
+	if err != nil {
+		return nil, nil, labels, err
+	}
+	after, err := side(args, func(r logRecord) bool {
+		return !r.Time.IsZero() && !r.Time.Before(split) && (diffWindow == 0 || r.Time.Before(split.Add(diffWindow)))
+	})
+	if err != nil {
+		return nil, nil, labels, err
+	}
+	at := split.Format("2006-01-02 15:04:05")
+	return before, after, [2]string{"before " + at, "after " + at}, nil
+}
+
+// formatPValue formats a p-value, or "-" for an untested change
+func formatPValue(p float64) string {
+	switch {
+	case p >= 1:
+		return "-"
+	case p < 0.001:
+		return "<0.001"
+	default:
+		return fmt.Sprintf("%.3f", p)
+	}
+}
+
+// formatRateDelta formats a change of rate in percentage points
+func formatRateDelta(delta float64) string {
+	return fmt.Sprintf("%+.1f pp", delta*100)
+}
+
+// changeMarker labels a new, gone or significant change, colored red for more
+// errors and green for fewer
+func changeMarker(change rateChange, isError bool) string {
+	var marker string
+	switch {
+	case change.isNew():
+		marker = "★ new"
+	case change.BeforeCount > 0 && change.AfterCount == 0:
+		marker = "gone"
+	case !change.significant(diffAlpha):
+		return ""
+	case change.delta() > 0:
+		marker = "▲ significant"
+	default:
+		marker = "▼ significant"
+	}
+
+	color := mutedColor
+	if isError {
+		color = clientErrorColor
+		if change.delta() < 0 {
+			color = successColor
+		}
+	}
+	return "  " + lipgloss.NewStyle().Foreground(color).Render(marker)
+}
+
+func displayStatusDiffWithLipgloss(labels [2]string, before, after *statusStats, codes, endpoints, failed []rateChange) {
+	displayListHeaderWithLipgloss(fmt.Sprintf("Status Changes: %s → %s", labels[0], labels[1]))
+	fmt.Println(lipgloss.NewStyle().Foreground(mutedColor).Render(
+		fmt.Sprintf("  %d requests → %d requests, significance level %g, Holm-adjusted over %d tests",
+			before.Total, after.Total, diffAlpha, testedCount(codes, endpoints))))
+	fmt.Println()
+
+	fmt.Printf("  %-32s %8s %8s %9s %8s\n", "Code", "Before", "After", "Change", "p (Holm)")
+	for _, change := range codes {
+		row := lipgloss.NewStyle().
+			Foreground(getStatusCodeColor(change.Code)).
+			Render(fmt.Sprintf("  %d %-28s", change.Code, truncateText(statusName(change.Code), 28)))
+		fmt.Printf("%s %8s %8s %9s %8s%s\n", row,
+			formatPercent(change.before()), formatPercent(change.after()),
+			formatRateDelta(change.delta()), formatPValue(change.AdjustedPValue),
+			changeMarker(change, change.Code >= 400))
+	}
+	fmt.Println()
+
+	var tested []rateChange
+	for _, change := range endpoints {
+		if change.PValue < 1 && len(tested) < diffEndpoints {
+			tested = append(tested, change)
+		}
+	}
+	if len(tested) > 0 {
+		displayListHeaderWithLipgloss("Endpoint Error Rates")
+		width := 0
+		for _, change := range tested {
+			width = max(width, len(change.Endpoint))
+		}
+		width = min(width, maxEndpointWidth)
+		fmt.Printf("  %-*s %8s %8s %9s %8s\n", width, "Endpoint", "Before", "After", "Change", "p (Holm)")
+		for _, change := range tested {
+			fmt.Printf("  %-*s %8s %8s %9s %8s%s\n", width, truncateText(change.Endpoint, width),
+				formatPercent(change.before()), formatPercent(change.after()),
+				formatRateDelta(change.delta()), formatPValue(change.AdjustedPValue),
+				changeMarker(change, true))
+		}
+		fmt.Println()
+	}
+
+	// New 5xx codes too rare to fail the gate are only reported
+	var rare []string
+	for _, change := range codes {
+		if change.Code/100 == 5 && change.isNew() && change.AfterCount < diffMinNew {
+			rare = append(rare, fmt.Sprintf("%d %s (%d requests)", change.Code, statusName(change.Code), change.AfterCount))
+		}
+	}
+	if len(rare) > 0 {
+		fmt.Println(lipgloss.NewStyle().Foreground(redirectionColor).Render(
+			fmt.Sprintf("⚠️  New 5xx:     %s, below --min-new %d", strings.Join(rare, ", "), diffMinNew)))
+	}
+
+	if len(failed) == 0 {
+		fmt.Println(lipgloss.NewStyle().Foreground(successColor).Render(
+			fmt.Sprintf("✅ No significant regression at α=%g", diffAlpha)))
+		fmt.Println()
+		return
+	}
+	var reasons []string
+	for _, change := range failed {
+		subject := change.Endpoint
+		if subject == "" {
+			subject = fmt.Sprintf("%d %s", change.Code, statusName(change.Code))
+		}
+		if change.isNew() {
+			reasons = append(reasons, fmt.Sprintf("%s is new (%d requests)", subject, change.AfterCount))
+		} else {
+			p := formatPValue(change.AdjustedPValue)
+			if !strings.HasPrefix(p, "<") {
+				p = "=" + p
+			}
+			reasons = append(reasons, fmt.Sprintf("%s %s (p%s)", subject, formatRateDelta(change.delta()), p))
+		}
+	}
+	style := lipgloss.NewStyle().Foreground(clientErrorColor)
+	for i, reason := range reasons {
+		label := "               "
+		if i == 0 {
+			label = "❌ Regression:  "
+		}
+		fmt.Println(style.Render(label + reason))
+	}
+	fmt.Println()
+}
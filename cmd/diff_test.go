@@ -0,0 +1,218 @@
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeDiffLog writes a log of n requests starting at minute, with the given
+// number of 502s to /orders/{id} and 503s to /health
+func writeDiffLog(t *testing.T, path string, minute, n, badGateway, unavailable int) {
+	t.Helper()
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		status, target := 200, fmt.Sprintf("/orders/%d", i)
+		switch {
+		case i < badGateway:
+			status = 502
+		case i < badGateway+unavailable:
+			status, target = 503, "/health"
+		}
+		fmt.Fprintf(&b, "10.0.0.1 - - [19/Oct/2026:10:%02d:%02d +0000] \"GET %s HTTP/1.1\" %d 0\n", minute+i%10, i%60, target, status)
+	}
+	if err := os.WriteFile(path, []byte(b.String()), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTwoProportionPValue(t *testing.T) {
+	tests := []struct {
+		name           string
+		x1, n1, x2, n2 int
+		want           float64
+	}{
+		// z = 4.297
+		{name: "1% to 4%", x1: 10, n1: 1000, x2: 40, n2: 1000, want: 1.73e-5},
+		// z = 0.98
+		{name: "no clear change", x1: 50, n1: 1000, x2: 60, n2: 1000, want: 0.3267},
+		{name: "same rate", x1: 20, n1: 1000, x2: 20, n2: 1000, want: 1},
+		{name: "too few occurrences", x1: 0, n1: 1000, x2: 4, n2: 1000, want: 1},
+		{name: "empty side", x1: 0, n1: 0, x2: 40, n2: 1000, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := twoProportionPValue(tt.x1, tt.n1, tt.x2, tt.n2)
+			if math.Abs(got-tt.want) > tt.want*0.02 {
+				t.Errorf("twoProportionPValue() = %g, want %g", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareStatusStats(t *testing.T) {
+	before, after := newStatusStats(), newStatusStats()
+	before.Templater, after.Templater = &pathTemplater{}, &pathTemplater{}
+	add := func(stats *statusStats, status, n int, path string) {
+		for i := 0; i < n; i++ {
+			stats.add(logRecord{Method: "GET", Path: fmt.Sprintf("%s/%d", path, i), Status: status})
+		}
+	}
+	add(before, 200, 990, "/orders")
+	add(before, 502, 10, "/orders")
+	add(after, 200, 950, "/orders")
+	add(after, 502, 40, "/orders")
+	add(after, 504, 10, "/orders")
+
+	codes := compareCodes(before, after)
+	endpoints := compareEndpoints(before, after)
+	adjustPValues(codes, endpoints)
+	if len(codes) != 3 || codes[2].Code != 504 || !codes[2].isNew() {
+		t.Fatalf("compareCodes() = %+v, want 200, 502 and a new 504", codes)
+	}
+	if !codes[1].significant(0.01) || codes[1].delta() <= 0 {
+		t.Errorf("Expected a significant rise of 502, got %+v", codes[1])
+	}
+
+	if len(endpoints) != 1 || endpoints[0].Endpoint != "GET /orders/{id}" || endpoints[0].AfterCount != 50 {
+		t.Fatalf("compareEndpoints() = %+v", endpoints)
+	}
+
+	failed := regressions(codes, endpoints, 0.01, minTestedCount)
+	if len(failed) != 3 {
+		t.Errorf("Expected 502, 504 and the endpoint as regressions, got %+v", failed)
+	}
+	if got := regressions(codes[:1], nil, 0.01, minTestedCount); len(got) != 0 {
+		t.Errorf("Expected a drop of 200 not to be a regression, got %+v", got)
+	}
+	stray := []rateChange{newRateChange(501, "", 0, 1000, 1, 1000)}
+	if got := regressions(stray, nil, 0.01, minTestedCount); len(got) != 0 {
+		t.Errorf("Expected a single new 501 not to be a regression, got %+v", got)
+	}
+}
+
+func TestAdjustPValues(t *testing.T) {
+	codes := []rateChange{
+		{Code: 500, PValue: 0.004},
+		{Code: 502, PValue: 0.01},
+		{Code: 503, PValue: 1},
+	}
+	endpoints := []rateChange{
+		{Endpoint: "GET /a", PValue: 0.03},
+		{Endpoint: "GET /b", PValue: 0.005},
+	}
+	adjustPValues(codes, endpoints)
+
+	// 4 tests: 0.004*4, max(0.005*3, 0.016), max(0.01*2, 0.016), max(0.03*1, 0.02)
+	tests := []struct {
+		change rateChange
+		want   float64
+	}{
+		{codes[0], 0.016},
+		{endpoints[1], 0.016},
+		{codes[1], 0.02},
+		{endpoints[0], 0.03},
+		{codes[2], 1},
+	}
+	for _, tt := range tests {
+		if math.Abs(tt.change.AdjustedPValue-tt.want) > 1e-9 {
+			t.Errorf("AdjustedPValue of %d%s = %g, want %g", tt.change.Code, tt.change.Endpoint, tt.change.AdjustedPValue, tt.want)
+		}
+	}
+	if codes[1].significant(0.01) {
+		t.Errorf("Expected p=0.01 not to be significant at 0.01 after the correction")
+	}
+}
+
+func TestParseSplitTime(t *testing.T) {
+	want := time.Date(2026, 10, 19, 10, 30, 0, 0, time.UTC)
+	for _, value := range []string{"2026-10-19T10:30:00Z", "2026-10-19T12:30:00+02:00"} {
+		got, err := parseSplitTime(value)
+		if err != nil || !got.Equal(want) {
+			t.Errorf("parseSplitTime(%q) = %v, %v, want %v", value, got, err, want)
+		}
+	}
+	if got, err := parseSplitTime("2026-10-19 10:30"); err != nil || got.Hour() != 10 || got.Location() != time.Local {
+		t.Errorf("parseSplitTime() = %v, %v, want 10:30 local time", got, err)
+	}
+	if _, err := parseSplitTime("yesterday"); err == nil {
+		t.Errorf("Expected an invalid split time error")
+	}
+}
+
+func TestStatsDiffCommand(t *testing.T) {
+	dir := t.TempDir()
+	baseline, canary := filepath.Join(dir, "baseline.log"), filepath.Join(dir, "canary.log")
+	writeDiffLog(t, baseline, 0, 2000, 10, 0)
+	writeDiffLog(t, canary, 30, 2000, 80, 6)
+	both := filepath.Join(dir, "both.log")
+	b, _ := os.ReadFile(baseline)
+	c, _ := os.ReadFile(canary)
+	os.WriteFile(both, append(b, c...), 0o644)
+	stray := filepath.Join(dir, "stray.log")
+	os.WriteFile(stray, append(b, []byte("10.0.0.1 - - [19/Oct/2026:10:40:00 +0000] \"GET /orders/1 HTTP/1.1\" 501 0\n")...), 0o644)
+	defer func() { diffSplit, diffWindow = "", 0 }()
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected []string
+	}{
+		{
+			name: "two logs",
+			args: []string{"stats", "diff", baseline, canary},
+			expected: []string{
+				"Status Changes: " + baseline + " → " + canary,
+				"2000 requests → 2000 requests",
+				"502 Bad Gateway",
+				"+3.5 pp",
+				"▲ significant",
+				"503 Service Unavailable",
+				"★ new",
+				"GET /orders/{id}",
+				"Holm-adjusted over",
+				"Regression:  502 Bad Gateway +3.5 pp (p<0.001)",
+				"503 Service Unavailable is new (6 requests)",
+			},
+		},
+		{
+			name: "split",
+			args: []string{"stats", "diff", "--split", "2026-10-19T10:30:00Z", "--window", "30m", both},
+			expected: []string{
+				"before 2026-10-19",
+				"2000 requests → 2000 requests",
+				"+3.5 pp",
+			},
+		},
+		{
+			name:     "same log",
+			args:     []string{"stats", "diff", baseline, baseline},
+			expected: []string{"+0.0 pp", "No significant regression at α=0.01"},
+		},
+		{
+			name:     "stray new 5xx",
+			args:     []string{"stats", "diff", baseline, stray},
+			expected: []string{"★ new", "New 5xx:     501 Not Implemented (1 requests), below --min-new 5", "No significant regression"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diffSplit, diffWindow = "", 0
+			stdout, _ := captureOutput(func() {
+				rootCmd.SetArgs(tt.args)
+				rootCmd.Execute()
+			})
+			for _, expected := range tt.expected {
+				if !strings.Contains(stdout, expected) {
+					t.Errorf("Expected '%s' in output, got: %s", expected, stdout)
+				}
+			}
+		})
+	}
+}
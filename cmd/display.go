@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 )
@@ -138,35 +139,57 @@ func getStatusCodeCategory(code int) string {
 	}
 }
 
+// displayEntry is a titled reference entry rendered like a status code lookup
+type displayEntry struct {
+	Title       string
+	Class       string
+	Color       lipgloss.Color
+	Description string
+	Notes       []string
+	Link        string
+}
+
 // displayCodeWithLipgloss displays HTTP status code information using Lipgloss styling
 func displayCodeWithLipgloss(code int, info HTTPCodeInfo) {
-	color := getStatusCodeColor(code)
-	category := getStatusCodeCategory(code)
-	
-	// Display the status code and description in one line
+	displayEntryWithLipgloss(displayEntry{
+		Title:       fmt.Sprintf("HTTP %d %s", code, info.Description),
+		Class:       getStatusCodeCategory(code),
+		Color:       getStatusCodeColor(code),
+		Description: info.Detail,
+		Link:        info.MDNLink,
+	})
+}
+
+// displayEntryWithLipgloss displays a reference entry using Lipgloss styling
+func displayEntryWithLipgloss(entry displayEntry) {
+	// Display the title in one line
 	header := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(color).
-		Render(fmt.Sprintf("           HTTP %d %s", code, info.Description))
+		Foreground(entry.Color).
+		Render(fmt.Sprintf("           %s", entry.Title))
 	fmt.Println(header)
-	
+
 	// Display category in one line
 	badge := lipgloss.NewStyle().
-		Foreground(color).
-		Render(fmt.Sprintf("📋 Class:       %s", category))
+		Foreground(entry.Color).
+		Render(fmt.Sprintf("📋 Class:       %s", entry.Class))
 	fmt.Println(badge)
-	
+
 	// Display detailed description in one line
 	description := lipgloss.NewStyle().
-		Render(fmt.Sprintf("📝 Description: %s", info.Detail))
+		Render(fmt.Sprintf("📝 Description: %s", entry.Description))
 	fmt.Println(description)
-	
-	// Display MDN link in one line
+
+	for _, note := range entry.Notes {
+		fmt.Printf("💡 Note:        %s\n", note)
+	}
+
+	// Display documentation link in one line
 	link := lipgloss.NewStyle().
 		Foreground(linkColor).
-		Render(fmt.Sprintf("🔗 Docs:        %s", info.MDNLink))
+		Render(fmt.Sprintf("🔗 Docs:        %s", entry.Link))
 	fmt.Println(link)
-	
+
 	// Add a simple separator
 	fmt.Println()
 }
@@ -217,3 +240,30 @@ func displaySummaryWithLipgloss(message string) {
 		Render(message)
 	fmt.Println(summary)
 }
+
+// formatRelatedCodes formats status codes as "code Description" pairs
+func formatRelatedCodes(codes []int) string {
+	if len(codes) == 0 {
+		return "-"
+	}
+	var parts []string
+	for _, code := range codes {
+		if description, exists := httpCodes[code]; exists {
+			parts = append(parts, fmt.Sprintf("%d %s", code, description))
+		} else {
+			parts = append(parts, fmt.Sprintf("%d", code))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatColoredCodes renders status codes colored by their category
+func formatColoredCodes(codes []int) string {
+	var parts []string
+	for _, code := range codes {
+		parts = append(parts, lipgloss.NewStyle().
+			Foreground(getStatusCodeColor(code)).
+			Render(formatRelatedCodes([]int{code})))
+	}
+	return strings.Join(parts, ", ")
+}
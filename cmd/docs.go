@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// Pager used when $PAGER is not set
+const defaultPager = "less -R"
+
+var docsNoPager bool
+
+// docsCmd represents the docs command
+var docsCmd = &cobra.Command{
+	Use:   "docs <code>",
+	Short: "Read the offline article for a status code",
+	Long: `Read a long-form article for a status code: its semantics, when to use it,
+common pitfalls and an example exchange. Articles are embedded in the binary,
+so they work without network access.
+
+Output goes through $PAGER (default "less -R") when writing to a terminal.
+Use --no-pager to print directly.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		code, err := parseStatusCode(args[0])
+		if err != nil {
+			displayErrorWithLipgloss(err.Error())
+			return
+		}
+		article, err := loadArticle(code)
+		if err != nil {
+			displayErrorWithLipgloss(err.Error())
+			return
+		}
+		showPaged(renderArticle(code, article), !docsNoPager)
+	},
+}
+
+func init() {
+	docsCmd.Flags().BoolVar(&docsNoPager, "no-pager", false, "print the article directly instead of using $PAGER")
+	rootCmd.AddCommand(docsCmd)
+}
+
+// showPaged writes text through $PAGER when paging is enabled and stdout is a
+// terminal, and falls back to printing it directly when the pager cannot
+// be started
+func showPaged(text string, paging bool) {
+	if paging && isTerminal(os.Stdout) {
+		pager := os.Getenv("PAGER")
+		if pager == "" {
+			pager = defaultPager
+		}
+		if args := strings.Fields(pager); len(args) > 0 {
+			command := exec.Command(args[0], args[1:]...)
+			command.Stdin = strings.NewReader(text)
+			command.Stdout = os.Stdout
+			command.Stderr = os.Stderr
+			if err := command.Start(); err == nil {
+				command.Wait()
+				return
+			}
+		}
+	}
+	fmt.Print(text)
+}
+
+// isTerminal reports whether a file is a character device such as a terminal
+func isTerminal(file *os.File) bool {
+	stat, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	return stat.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"fmt"
+	"io/fs"
+	"strings"
+	"testing"
+)
+
+func TestArticlesCoverCodes(t *testing.T) {
+	for code := range httpCodesInfo {
+		t.Run(fmt.Sprint(code), func(t *testing.T) {
+			article, err := loadArticle(code)
+			if err != nil {
+				t.Fatalf("Status code %d has no article: %v", code, err)
+			}
+
+			if !strings.HasPrefix(article, fmt.Sprintf("# %d ", code)) {
+				t.Errorf("Article for %d does not start with its title", code)
+			}
+
+			for _, section := range []string{"## Semantics", "## When to use", "## Pitfalls", "## Example", "## See also"} {
+				if !strings.Contains(article, section) {
+					t.Errorf("Article for %d missing section %q", code, section)
+				}
+			}
+		})
+	}
+
+	// Every embedded article belongs to a registered status code
+	names, _ := fs.Glob(articleFiles, "articles/*.md")
+	if len(names) != len(httpCodesInfo) {
+		t.Errorf("Expected %d articles, found %d", len(httpCodesInfo), len(names))
+	}
+}
+
+func TestRenderArticle(t *testing.T) {
+	markdown := "# 418 I'm a teapot\n\n## Pitfalls\n\n- Not for **real** errors, see `RFC 9110`.\n  Keep it fun.\n\n```\nBREW /pot-1 HTCPCP/1.0\n```\n"
+	rendered := renderArticle(418, markdown)
+
+	wantContains := []string{
+		"HTTP 418 I'm a teapot",
+		"▌ Pitfalls",
+		"  • Not for real errors, see RFC 9110.",
+		"    Keep it fun.",
+		"    BREW /pot-1 HTCPCP/1.0",
+	}
+	for _, want := range wantContains {
+		if !strings.Contains(rendered, want) {
+			t.Errorf("Expected '%s' in output, got: %s", want, rendered)
+		}
+	}
+
+	for _, unwanted := range []string{"```", "**", "`", "## "} {
+		if strings.Contains(rendered, unwanted) {
+			t.Errorf("Expected no '%s' in output, got: %s", unwanted, rendered)
+		}
+	}
+}
+
+func TestDocsCommand(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         []string
+		wantContains []string
+	}{
+		{
+			name:         "article",
+			args:         []string{"docs", "429"},
+			wantContains: []string{"HTTP 429 Too Many Requests", "▌ Semantics", "Retry-After"},
+		},
+		{
+			name:         "unregistered code",
+			args:         []string{"docs", "999"},
+			wantContains: []string{"HTTP status code 999 not found"},
+		},
+		{
+			name:         "invalid code",
+			args:         []string{"docs", "abc"},
+			wantContains: []string{"invalid HTTP status code abc"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Captured output is not a terminal, so no pager is started
+			stdout, _ := captureOutput(func() {
+				rootCmd.SetArgs(tt.args)
+				rootCmd.Execute()
+			})
+
+			for _, want := range tt.wantContains {
+				if !strings.Contains(stdout, want) {
+					t.Errorf("Expected '%s' in output, got: %s", want, stdout)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Number of endpoints shown per class, set with --endpoints
+var statsEndpoints int
+
+// Path segments replaced by placeholders when templating paths
+var (
+	uuidSegmentPattern    = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	numericSegmentPattern = regexp.MustCompile(`^\d+$`)
+	hashSegmentPattern    = regexp.MustCompile(`^[0-9a-fA-F]{16,}$`)
+	// Opaque IDs such as ord_8f2k1x9q or 5f3Kd92LmQ: long, with several digits
+	idSegmentPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{8,}$`)
+)
+
+// pathTemplateRule rewrites paths matching a user-defined pattern
+type pathTemplateRule struct {
+	pattern  *regexp.Regexp
+	template string
+}
+
+// pathTemplater turns request paths into endpoint templates such as
+// /users/{id}/orders
+type pathTemplater struct {
+	rules []pathTemplateRule
+}
+
+// loadPathTemplater builds a templater with the path_templates rules of the
+// config file
+func loadPathTemplater() (*pathTemplater, error) {
+	config, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	templater := &pathTemplater{}
+	for _, c := range config.PathTemplates {
+		pattern, err := regexp.Compile(c.Match)
+		if err != nil {
+			return nil, fmt.Errorf("path template %s: %v", c.Match, err)
+		}
+		templater.rules = append(templater.rules, pathTemplateRule{pattern: pattern, template: c.Template})
+	}
+	return templater, nil
+}
+
+// template returns the endpoint template of a path. The first matching user
+// rule is applied, then IDs, UUIDs, hashes and numbers left in the path are
+// replaced by placeholders.
+func (p *pathTemplater) template(path string) string {
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+	for _, rule := range p.rules {
+		if rule.pattern.MatchString(path) {
+			path = rule.pattern.ReplaceAllString(path, rule.template)
+			break
+		}
+	}
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = templateSegment(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// templateSegment returns the placeholder of a variable path segment, or the
+// segment itself
+func templateSegment(segment string) string {
+	switch {
+	case segment == "" || strings.HasPrefix(segment, "{"):
+		return segment
+	case uuidSegmentPattern.MatchString(segment):
+		return "{uuid}"
+	case numericSegmentPattern.MatchString(segment):
+		return "{id}"
+	case hashSegmentPattern.MatchString(segment) && strings.ContainsAny(segment, "0123456789"):
+		return "{hash}"
+	case idSegmentPattern.MatchString(segment) && countDigits(segment) >= 3:
+		return "{id}"
+	default:
+		return segment
+	}
+}
+
+func countDigits(s string) int {
+	count := 0
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			count++
+		}
+	}
+	return count
+}
+
+// endpointCounts are the status counts of one method and path template
+type endpointCounts struct {
+	Method   string
+	Template string
+	Counts   map[int]int
+}
+
+// total returns the number of requests to the endpoint
+func (e endpointCounts) total() int {
+	total := 0
+	for _, count := range e.Counts {
+		total += count
+	}
+	return total
+}
+
+// classCount returns the endpoint's requests in a status class
+func (e endpointCounts) classCount(class int) int {
+	return classCounts(e.Counts)[class]
+}
+
+// dominantCodes returns the codes of a class from the most to the least
+// frequent
+func (e endpointCounts) dominantCodes(class int) []int {
+	var codes []int
+	for code := range e.Counts {
+		if code/100 == class {
+			codes = append(codes, code)
+		}
+	}
+	sort.Slice(codes, func(i, j int) bool {
+		ci, cj := e.Counts[codes[i]], e.Counts[codes[j]]
+		if ci != cj {
+			return ci > cj
+		}
+		return codes[i] < codes[j]
+	})
+	return codes
+}
+
+// topEndpoints returns the n endpoints with the most responses in a class
+func (s *statusStats) topEndpoints(class, n int) []endpointCounts {
+	var endpoints []endpointCounts
+	for _, endpoint := range s.sortedEndpoints() {
+		if endpoint.classCount(class) > 0 {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		return endpoints[i].classCount(class) > endpoints[j].classCount(class)
+	})
+	if len(endpoints) > n {
+		endpoints = endpoints[:n]
+	}
+	return endpoints
+}
+
+// sortedEndpoints returns every endpoint ordered by template and method
+func (s *statusStats) sortedEndpoints() []endpointCounts {
+	var endpoints []endpointCounts
+	for key, counts := range s.Endpoints {
+		method, template, _ := strings.Cut(key, " ")
+		endpoints = append(endpoints, endpointCounts{Method: method, Template: template, Counts: counts})
+	}
+	sort.Slice(endpoints, func(i, j int) bool {
+		if endpoints[i].Template != endpoints[j].Template {
+			return endpoints[i].Template < endpoints[j].Template
+		}
+		return endpoints[i].Method < endpoints[j].Method
+	})
+	return endpoints
+}
+
+// Widest endpoint column before templates are truncated
+const maxEndpointWidth = 48
+
+// Dominant codes listed under each endpoint
+const endpointDominantCodes = 3
+
+func displayTopEndpointsWithLipgloss(stats *statusStats, n int) {
+	for _, class := range []int{5, 4} {
+		endpoints := stats.topEndpoints(class, n)
+		displayListHeaderWithLipgloss(fmt.Sprintf("Top Endpoints by %dxx", class))
+		if len(endpoints) == 0 {
+			fmt.Println(lipgloss.NewStyle().Foreground(mutedColor).Render(fmt.Sprintf("  No %dxx responses", class)))
+			fmt.Println()
+			continue
+		}
+
+		width := 0
+		for _, endpoint := range endpoints {
+			width = max(width, len(endpoint.Method)+1+len(endpoint.Template))
+		}
+		width = min(width, maxEndpointWidth)
+
+		color := getStatusCodeColor(class * 100)
+		for _, endpoint := range endpoints {
+			name := truncateText(endpoint.Method+" "+endpoint.Template, width)
+			count := endpoint.classCount(class)
+			share := fmt.Sprintf("%s of %d", formatPercent(float64(count)/float64(endpoint.total())), endpoint.total())
+			fmt.Printf("  %-*s %s %s\n", width, name,
+				lipgloss.NewStyle().Foreground(color).Render(fmt.Sprintf("%8d %dxx", count, class)),
+				lipgloss.NewStyle().Foreground(mutedColor).Render(share))
+
+			var dominant []string
+			for i, code := range endpoint.dominantCodes(class) {
+				if i == endpointDominantCodes {
+					break
+				}
+				dominant = append(dominant, lipgloss.NewStyle().
+					Foreground(getStatusCodeColor(code)).
+					Render(fmt.Sprintf("%d %s %d", code, statusName(code), endpoint.Counts[code])))
+			}
+			fmt.Printf("    ↳ %s\n", strings.Join(dominant, " · "))
+		}
+		fmt.Println()
+	}
+}
+
+// writeEndpointStatsCSV writes one row per endpoint and status code
+func writeEndpointStatsCSV(w io.Writer, stats *statusStats) error {
+	out := csv.NewWriter(w)
+	out.Write([]string{"method", "endpoint", "code", "description", "count"})
+	for _, endpoint := range stats.sortedEndpoints() {
+		var codes []int
+		for code := range endpoint.Counts {
+			codes = append(codes, code)
+		}
+		sort.Ints(codes)
+		for _, code := range codes {
+			out.Write([]string{endpoint.Method, endpoint.Template, strconv.Itoa(code), statusName(code), strconv.Itoa(endpoint.Counts[code])})
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
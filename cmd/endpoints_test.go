@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// Sample access log with failing endpoints
+const sampleEndpointLog = `10.0.0.1 - - [19/Oct/2026:10:00:01 +0000] "GET /users/42/orders?page=2 HTTP/1.1" 502 1
+10.0.0.1 - - [19/Oct/2026:10:00:01 +0000] "GET /users/43/orders HTTP/1.1" 502 1
+10.0.0.1 - - [19/Oct/2026:10:00:01 +0000] "GET /users/44/orders HTTP/1.1" 504 1
+10.0.0.1 - - [19/Oct/2026:10:00:01 +0000] "GET /users/45/orders HTTP/1.1" 200 1
+10.0.0.1 - - [19/Oct/2026:10:00:01 +0000] "POST /carts/3f2b8c1e-9d4a-4b7e-8f6a-2c1d0e9b8a7f/checkout HTTP/1.1" 500 1
+10.0.0.1 - - [19/Oct/2026:10:00:01 +0000] "GET /orders/ord_8f2k1x9q HTTP/1.1" 404 1
+10.0.0.1 - - [19/Oct/2026:10:00:01 +0000] "GET /orders/ord_7a1b2c3d HTTP/1.1" 410 1
+10.0.0.1 - - [19/Oct/2026:10:00:01 +0000] "GET /orders/ord_7a1b2c3d HTTP/1.1" 404 1
+`
+
+func TestPathTemplate(t *testing.T) {
+	templater := &pathTemplater{}
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/users/42/orders", want: "/users/{id}/orders"},
+		{path: "/users/42/orders?page=2#top", want: "/users/{id}/orders"},
+		{path: "/carts/3F2B8C1E-9D4A-4B7E-8F6A-2C1D0E9B8A7F", want: "/carts/{uuid}"},
+		{path: "/assets/9f86d081884c7d659a2feaa0c55ad015/app.js", want: "/assets/{hash}/app.js"},
+		{path: "/commits/da39a3ee5e6b4b0d3255bfef95601890afd80709", want: "/commits/{hash}"},
+		{path: "/orders/ord_8f2k1x9q", want: "/orders/{id}"},
+		{path: "/api/v2/health", want: "/api/v2/health"},
+		{path: "/blog/my-first-post", want: "/blog/my-first-post"},
+		{path: "/", want: "/"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := templater.template(tt.path); got != tt.want {
+				t.Errorf("template(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUserPathTemplates(t *testing.T) {
+	writeTestConfig(t, `path_templates:
+  - match: '^/repos/[^/]+/[^/]+'
+    template: '/repos/{owner}/{repo}'
+  - match: '^/@([a-z]+)'
+    template: '/@{user}'
+`)
+
+	templater, err := loadPathTemplater()
+	if err != nil {
+		t.Fatalf("loadPathTemplater() error = %v", err)
+	}
+	tests := map[string]string{
+		"/repos/golang/go/issues/123": "/repos/{owner}/{repo}/issues/{id}",
+		"/@alice/posts":               "/@{user}/posts",
+		"/users/7":                    "/users/{id}",
+	}
+	for path, want := range tests {
+		if got := templater.template(path); got != want {
+			t.Errorf("template(%q) = %q, want %q", path, got, want)
+		}
+	}
+
+	writeTestConfig(t, "path_templates:\n  - match: '('\n    template: x\n")
+	if _, err := loadPathTemplater(); err == nil || !strings.Contains(err.Error(), "path template (") {
+		t.Errorf("Expected an invalid path template error, got %v", err)
+	}
+}
+
+func TestTopEndpoints(t *testing.T) {
+	stats := newStatusStats()
+	stats.Templater = &pathTemplater{}
+	if err := collectStatusStats(strings.NewReader(sampleEndpointLog), logFormatPresets, stats); err != nil {
+		t.Fatal(err)
+	}
+
+	top := stats.topEndpoints(5, 10)
+	if len(top) != 2 || top[0].Template != "/users/{id}/orders" || top[1].Method != "POST" {
+		t.Fatalf("topEndpoints(5) = %+v", top)
+	}
+	if got := top[0].dominantCodes(5); !reflect.DeepEqual(got, []int{502, 504}) {
+		t.Errorf("dominantCodes(5) = %v, want [502 504]", got)
+	}
+	if top[0].total() != 4 || top[0].classCount(5) != 3 {
+		t.Errorf("Expected 3 5xx in 4 requests, got %d in %d", top[0].classCount(5), top[0].total())
+	}
+	if got := stats.topEndpoints(4, 1); len(got) != 1 || got[0].Template != "/orders/{id}" {
+		t.Errorf("topEndpoints(4, 1) = %+v", got)
+	}
+}
+
+func TestStatsEndpoints(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "access.log")
+	if err := os.WriteFile(path, []byte(sampleEndpointLog), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { statsEndpoints, statsOutput = 0, "text" }()
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected []string
+	}{
+		{
+			name: "top endpoints",
+			args: []string{"stats", "--endpoints", "5", path},
+			expected: []string{
+				"Top Endpoints by 5xx",
+				"GET /users/{id}/orders",
+				"3 5xx",
+				"75.0% of 4",
+				"502 Bad Gateway 2 · 504 Gateway Timeout 1",
+				"Top Endpoints by 4xx",
+				"404 Not Found 2 · 410 Gone 1",
+			},
+		},
+		{
+			name: "csv",
+			args: []string{"stats", "--endpoints", "5", "-o", "csv", path},
+			expected: []string{
+				"method,endpoint,code,description,count",
+				"GET,/users/{id}/orders,502,Bad Gateway,2",
+				"POST,/carts/{uuid}/checkout,500,Internal Server Error,1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statsEndpoints, statsOutput = 0, "text"
+			stdout, _ := captureOutput(func() {
+				rootCmd.SetArgs(tt.args)
+				rootCmd.Execute()
+			})
+			for _, expected := range tt.expected {
+				if !strings.Contains(stdout, expected) {
+					t.Errorf("Expected '%s' in output, got: %s", expected, stdout)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,247 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+// Colors used for Envoy response flag kinds
+var envoyKindColors = map[string]lipgloss.Color{
+	envoyKindUpstream:   serverErrorColor,
+	envoyKindTimeout:    redirectionColor,
+	envoyKindRouting:    clientErrorColor,
+	envoyKindDownstream: informationalColor,
+	envoyKindPolicy:     globalFailureColor,
+	envoyKindOverload:   mutedColor,
+}
+
+// Order of kinds in the response flag list
+var envoyKindOrder = []string{envoyKindUpstream, envoyKindTimeout, envoyKindRouting, envoyKindDownstream, envoyKindPolicy, envoyKindOverload}
+
+// A status code among the arguments, e.g. "503 UF"
+var envoyStatusArg = regexp.MustCompile(`^[1-9]\d\d$`)
+
+// envoyCmd represents the envoy command
+var envoyCmd = &cobra.Command{
+	Use:   "envoy [flags]",
+	Short: "Explain Envoy response flags",
+	Long: `Explain the response flags of Envoy access logs (%RESPONSE_FLAGS%), such as
+UH, UF, URX, UO, NR, DC and RL. Envoy pairs them with the status it logs, and
+they are the real reason behind a 503 that the upstream never sent.
+
+Flags can be separated by commas or spaces, and given by their long names
+(e.g. NoHealthyUpstream). A status code among them, as in 'httpcode envoy 503 UF',
+is checked against the status Envoy usually logs with each flag.
+
+Running 'httpcode envoy' without arguments lists all response flags.`,
+	Args: cobra.ArbitraryArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 {
+			listEnvoyFlags()
+			return
+		}
+		lookupEnvoyFlags(args)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(envoyCmd)
+}
+
+// lookupEnvoyFlags explains the response flags among the arguments
+func lookupEnvoyFlags(args []string) {
+	status := 0
+	var flags []string
+	for _, arg := range args {
+		for _, flag := range strings.FieldsFunc(arg, func(r rune) bool {
+			return r == ',' || r == ' '
+		}) {
+			if envoyStatusArg.MatchString(flag) {
+				status, _ = strconv.Atoi(flag)
+				continue
+			}
+			flags = append(flags, flag)
+		}
+	}
+
+	if len(flags) == 1 && flags[0] == "-" {
+		note := "No response flags: Envoy passed on the response of the upstream."
+		if status >= 400 {
+			note = fmt.Sprintf("No response flags: the upstream sent %s itself.", formatRelatedCodes([]int{status}))
+		}
+		fmt.Println(lipgloss.NewStyle().Foreground(textColor).Render(note))
+		return
+	}
+
+	for _, flag := range splitEnvoyFlags(strings.Join(flags, ",")) {
+		if info, exists := findEnvoyFlag(flag); exists {
+			displayEnvoyFlagWithLipgloss(info, status)
+		} else {
+			displayErrorWithLipgloss(fmt.Sprintf("Envoy response flag %s not found", flag))
+		}
+	}
+}
+
+func listEnvoyFlags() {
+	displayListHeaderWithLipgloss("Envoy Response Flags")
+	for _, kind := range envoyKindOrder {
+		title := lipgloss.NewStyle().
+			Bold(true).
+			Foreground(envoyKindColors[kind]).
+			Render(kind)
+		fmt.Println(title)
+
+		for _, info := range envoyFlagsInfo {
+			if info.Kind != kind {
+				continue
+			}
+			flag := lipgloss.NewStyle().
+				Foreground(envoyKindColors[kind]).
+				Render(fmt.Sprintf("  %-6s", info.Flag))
+			status := lipgloss.NewStyle().
+				Foreground(mutedColor).
+				Render(formatEnvoyStatuses(info))
+			fmt.Printf("%s %-34s %s\n", flag, info.Name, status)
+		}
+	}
+}
+
+// formatEnvoyStatuses formats the statuses Envoy usually logs with a flag
+func formatEnvoyStatuses(info EnvoyFlagInfo) string {
+	var parts []string
+	for _, code := range info.Statuses {
+		parts = append(parts, strconv.Itoa(code))
+	}
+	if info.StatusNote != "" {
+		parts = append(parts, info.StatusNote)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// usualEnvoyStatus reports whether Envoy usually logs a flag with a status;
+// flags without usual statuses go with any
+func usualEnvoyStatus(info EnvoyFlagInfo, status int) bool {
+	if len(info.Statuses) == 0 {
+		return true
+	}
+	for _, code := range info.Statuses {
+		if code == status {
+			return true
+		}
+	}
+	return false
+}
+
+// displayEnvoyFlagWithLipgloss displays an Envoy response flag using Lipgloss
+// styling; a non-zero status is checked against the flag's usual statuses
+func displayEnvoyFlagWithLipgloss(info EnvoyFlagInfo, status int) {
+	color := envoyKindColors[info.Kind]
+
+	header := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(color).
+		Render(fmt.Sprintf("           %s %s", info.Flag, info.Name))
+	fmt.Println(header)
+
+	kind := lipgloss.NewStyle().
+		Foreground(color).
+		Render(fmt.Sprintf("📋 Kind:        %s", info.Kind))
+	fmt.Println(kind)
+
+	fmt.Printf("📝 Description: %s\n", info.Description)
+
+	statuses := "-"
+	if len(info.Statuses) > 0 {
+		statuses = lipgloss.NewStyle().
+			Foreground(getStatusCodeColor(info.Statuses[0])).
+			Render(formatRelatedCodes(info.Statuses))
+	}
+	if info.StatusNote != "" {
+		if len(info.Statuses) > 0 {
+			statuses += fmt.Sprintf(" (%s)", info.StatusNote)
+		} else {
+			statuses = info.StatusNote
+		}
+	}
+	fmt.Printf("🌐 Status:      %s\n", statuses)
+
+	if status != 0 && !usualEnvoyStatus(info, status) {
+		note := lipgloss.NewStyle().
+			Foreground(clientErrorColor).
+			Render(fmt.Sprintf("⚠️  Unusual:     logged with %s; a filter or the upstream may have set the status", formatRelatedCodes([]int{status})))
+		fmt.Println(note)
+	}
+
+	for i, cause := range info.Causes {
+		if i == 0 {
+			fmt.Printf("🔍 Causes:      - %s\n", cause)
+		} else {
+			fmt.Printf("                - %s\n", cause)
+		}
+	}
+
+	link := lipgloss.NewStyle().
+		Foreground(linkColor).
+		Render(fmt.Sprintf("🔗 Docs:        %s", envoyResponseFlagsLink))
+	fmt.Println(link)
+
+	fmt.Println()
+}
+
+// displayEnvoyFlagStatsWithLipgloss displays the response flags found in an
+// Envoy log, decoded, with the statuses logged with each
+func displayEnvoyFlagStatsWithLipgloss(stats *statusStats) {
+	totals := make(map[string]int)
+	var flags []string
+	for flag, counts := range stats.EnvoyFlags {
+		for _, count := range counts {
+			totals[flag] += count
+		}
+		flags = append(flags, flag)
+	}
+	sort.Slice(flags, func(i, j int) bool {
+		if totals[flags[i]] != totals[flags[j]] {
+			return totals[flags[i]] > totals[flags[j]]
+		}
+		return flags[i] < flags[j]
+	})
+
+	displayListHeaderWithLipgloss("Envoy Response Flags")
+	for _, flag := range flags {
+		info, exists := findEnvoyFlag(flag)
+		color, name, description := mutedColor, "unknown", "Not a known response flag."
+		if exists {
+			color, name, description = envoyKindColors[info.Kind], info.Name, info.Description
+		}
+
+		var codes []int
+		for code := range stats.EnvoyFlags[flag] {
+			codes = append(codes, code)
+		}
+		sort.Ints(codes)
+		var statuses []string
+		for _, code := range codes {
+			status := strconv.Itoa(code)
+			if code == 0 {
+				status = "no response"
+			}
+			statuses = append(statuses, fmt.Sprintf("%s (%d)", status, stats.EnvoyFlags[flag][code]))
+		}
+
+		title := lipgloss.NewStyle().
+			Bold(true).
+			Foreground(color).
+			Render(fmt.Sprintf("  %-6s %-34s", flag, name))
+		fmt.Printf("%s %8d  %s\n", title, totals[flag], lipgloss.NewStyle().
+			Foreground(mutedColor).
+			Render(strings.Join(statuses, ", ")))
+		fmt.Println(lipgloss.NewStyle().Foreground(textColor).Render("         " + description))
+	}
+	fmt.Println()
+}
@@ -0,0 +1,237 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestEnvoyFlagsInfoStructure(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, info := range envoyFlagsInfo {
+		t.Run(info.Flag, func(t *testing.T) {
+			if seen[info.Flag] || seen[info.Name] {
+				t.Errorf("Envoy response flag %s is defined more than once", info.Flag)
+			}
+			seen[info.Flag], seen[info.Name] = true, true
+
+			if info.Name == "" || info.Description == "" || len(info.Causes) == 0 {
+				t.Errorf("Envoy response flag %s missing Name, Description or Causes", info.Flag)
+			}
+			if len(info.Statuses) == 0 && info.StatusNote == "" {
+				t.Errorf("Envoy response flag %s has neither Statuses nor StatusNote", info.Flag)
+			}
+
+			if _, exists := envoyKindColors[info.Kind]; !exists {
+				t.Errorf("Envoy response flag %s has unknown kind %q", info.Flag, info.Kind)
+			}
+
+			for _, code := range info.Statuses {
+				if _, exists := httpCodesInfo[code]; !exists {
+					t.Errorf("Envoy response flag %s maps to unknown status code %d", info.Flag, code)
+				}
+			}
+		})
+	}
+
+	// Flags named in the request must resolve
+	for _, flag := range []string{"UH", "UF", "URX", "UO", "NR", "DC", "RL"} {
+		if _, exists := findEnvoyFlag(flag); !exists {
+			t.Errorf("Envoy response flag %s not found", flag)
+		}
+	}
+}
+
+func TestFindEnvoyFlag(t *testing.T) {
+	tests := []struct {
+		arg      string
+		wantFlag string
+		wantOK   bool
+	}{
+		{"UH", "UH", true},
+		{"urx", "URX", true},
+		{"NoHealthyUpstream", "UH", true},
+		{"upstreamrequesttimeout", "UT", true},
+		{"XX", "", false},
+		{"-", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			info, ok := findEnvoyFlag(tt.arg)
+			if ok != tt.wantOK {
+				t.Fatalf("findEnvoyFlag(%q) found = %v, expected %v", tt.arg, ok, tt.wantOK)
+			}
+			if ok && info.Flag != tt.wantFlag {
+				t.Errorf("findEnvoyFlag(%q) = %s, expected %s", tt.arg, info.Flag, tt.wantFlag)
+			}
+		})
+	}
+}
+
+func TestSplitEnvoyFlags(t *testing.T) {
+	tests := []struct {
+		flags string
+		want  string
+	}{
+		{"UF,URX", "UF URX"},
+		{"UH", "UH"},
+		{"-", ""},
+		{"", ""},
+		{"UF URX", "UF URX"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.flags, func(t *testing.T) {
+			if got := strings.Join(splitEnvoyFlags(tt.flags), " "); got != tt.want {
+				t.Errorf("splitEnvoyFlags(%q) = %q, expected %q", tt.flags, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnvoyStatusZero(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields logFields
+		wantOK bool
+	}{
+		{name: "with flags", fields: logFields{Status: "0", Flags: "DC"}, wantOK: true},
+		{name: "without flags", fields: logFields{Status: "0", Flags: "-"}},
+		{name: "not envoy", fields: logFields{Status: "0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := buildLogRecord(tt.fields, ""); ok != tt.wantOK {
+				t.Errorf("buildLogRecord(%+v) ok = %v, want %v", tt.fields, ok, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestEnvoyCommand(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         []string
+		wantContains []string
+		wantMissing  []string
+	}{
+		{
+			name:         "list",
+			args:         []string{"envoy"},
+			wantContains: []string{"Envoy Response Flags", "Upstream", "UH     NoHealthyUpstream", "RL     RateLimited", "429"},
+		},
+		{
+			name:         "single flag",
+			args:         []string{"envoy", "UH"},
+			wantContains: []string{"UH NoHealthyUpstream", "Kind:        Upstream", "503 Service Unavailable", "health checks", "response-flags"},
+		},
+		{
+			name:         "comma-separated flags",
+			args:         []string{"envoy", "UF,URX"},
+			wantContains: []string{"UF UpstreamConnectionFailure", "URX UpstreamRetryLimitExceeded"},
+		},
+		{
+			name:         "long name",
+			args:         []string{"envoy", "RateLimited"},
+			wantContains: []string{"RL RateLimited", "429 Too Many Requests"},
+		},
+		{
+			name:         "usual status",
+			args:         []string{"envoy", "503", "UO"},
+			wantContains: []string{"UO UpstreamOverflow", "circuit breaker"},
+			wantMissing:  []string{"Unusual"},
+		},
+		{
+			name:         "unusual status",
+			args:         []string{"envoy", "200", "NR"},
+			wantContains: []string{"Unusual:     logged with 200 OK"},
+		},
+		{
+			name:         "no flags",
+			args:         []string{"envoy", "503", "-"},
+			wantContains: []string{"the upstream sent 503 Service Unavailable itself"},
+		},
+		{
+			name:         "unknown flag",
+			args:         []string{"envoy", "XX"},
+			wantContains: []string{"Envoy response flag XX not found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout, _ := captureOutput(func() {
+				rootCmd.SetArgs(tt.args)
+				rootCmd.Execute()
+			})
+			for _, expected := range tt.wantContains {
+				if !strings.Contains(stdout, expected) {
+					t.Errorf("Expected '%s' in output, got: %s", expected, stdout)
+				}
+			}
+			for _, missing := range tt.wantMissing {
+				if strings.Contains(stdout, missing) {
+					t.Errorf("Expected '%s' not in output, got: %s", missing, stdout)
+				}
+			}
+		})
+	}
+}
+
+func TestStatsEnvoyFlags(t *testing.T) {
+	lines := []string{
+		`[2026-10-19T10:00:01.000Z] "GET /users/1 HTTP/1.1" 503 UH 0 19 0 - "10.0.0.1" "curl/8.5.0" "a1" "example.com" "-"`,
+		`[2026-10-19T10:00:02.000Z] "GET /users/2 HTTP/1.1" 503 UH 0 19 0 - "10.0.0.1" "curl/8.5.0" "a2" "example.com" "-"`,
+		`[2026-10-19T10:00:03.000Z] "GET /users/3 HTTP/1.1" 503 UF,URX 0 91 30 - "10.0.0.1" "curl/8.5.0" "a3" "example.com" "10.0.0.9:8080"`,
+		`[2026-10-19T10:00:04.000Z] "GET /users/4 HTTP/1.1" 200 - 0 42 12 10 "10.0.0.1" "curl/8.5.0" "a4" "example.com" "10.0.0.9:8080"`,
+		`[2026-10-19T10:00:05.000Z] "GET /users/5 HTTP/1.1" 0 DC 0 0 2500 - "10.0.0.1" "curl/8.5.0" "a5" "example.com" "10.0.0.9:8080"`,
+	}
+	path := filepath.Join(t.TempDir(), "envoy.log")
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { statsUpstream = false }()
+
+	t.Run("flags decoded", func(t *testing.T) {
+		stdout, _ := captureOutput(func() {
+			rootCmd.SetArgs([]string{"stats", path})
+			rootCmd.Execute()
+		})
+		expected := []string{
+			"Status Codes in 4 Requests",
+			"No response: 1 requests ended before a status was sent",
+			"DC     DownstreamConnectionTermination           1  no response (1)",
+			"Envoy Response Flags",
+			"UH     NoHealthyUpstream                         2  503 (2)",
+			"No healthy host in the upstream cluster",
+			"UF     UpstreamConnectionFailure                 1  503 (1)",
+			"URX    UpstreamRetryLimitExceeded",
+		}
+		for _, e := range expected {
+			if !strings.Contains(stdout, e) {
+				t.Errorf("Expected '%s' in output, got: %s", e, stdout)
+			}
+		}
+	})
+
+	t.Run("attribution explains flags", func(t *testing.T) {
+		stdout, _ := captureOutput(func() {
+			rootCmd.SetArgs([]string{"stats", "--upstream", path})
+			rootCmd.Execute()
+		})
+		expected := []string{
+			"generated by Envoy (UH)",
+			"no response · client abort (DC)",
+			"UH: No healthy host in the upstream cluster",
+			"UF: Envoy could not establish a connection to the upstream host. URX: Every retry failed",
+		}
+		for _, e := range expected {
+			if !strings.Contains(stdout, e) {
+				t.Errorf("Expected '%s' in output, got: %s", e, stdout)
+			}
+		}
+	})
+}
@@ -0,0 +1,365 @@
+package cmd
+
+import (
+	"strings"
+)
+
+// Kinds of Envoy response flags
+const (
+	envoyKindUpstream   = "Upstream"
+	envoyKindTimeout    = "Timeout"
+	envoyKindRouting    = "Routing"
+	envoyKindDownstream = "Downstream"
+	envoyKindPolicy     = "Policy"
+	envoyKindOverload   = "Overload"
+)
+
+// Documentation of the response flags of Envoy access logs
+const envoyResponseFlagsLink = "https://www.envoyproxy.io/docs/envoy/latest/configuration/observability/access_log/usage#config-access-log-format-response-flags"
+
+// EnvoyFlagInfo contains information about an Envoy response flag, the
+// reason Envoy logs for a response it generated or cut short
+type EnvoyFlagInfo struct {
+	Flag        string
+	Name        string
+	Kind        string
+	Description string
+	// Statuses Envoy usually logs with the flag; none when it varies
+	Statuses   []int
+	StatusNote string
+	Causes     []string
+}
+
+// Envoy response flags, as logged by %RESPONSE_FLAGS% and
+// %RESPONSE_FLAGS_LONG%
+var envoyFlagsInfo = []EnvoyFlagInfo{
+	{
+		Flag:        "UH",
+		Name:        "NoHealthyUpstream",
+		Kind:        envoyKindUpstream,
+		Description: "No healthy host in the upstream cluster, so Envoy did not even try to connect.",
+		Statuses:    []int{503},
+		Causes: []string{
+			"Every endpoint failed active health checks or was ejected by outlier detection",
+			"The cluster has no endpoints: the service has no ready pods or a selector matches nothing",
+			"Panic threshold disabled while most hosts are unhealthy",
+		},
+	},
+	{
+		Flag:        "UF",
+		Name:        "UpstreamConnectionFailure",
+		Kind:        envoyKindUpstream,
+		Description: "Envoy could not establish a connection to the upstream host.",
+		Statuses:    []int{503},
+		Causes: []string{
+			"Nothing listens on the upstream port, or the connection was refused or timed out (connect_timeout)",
+			"TLS or mTLS handshake failure, e.g. a plaintext upstream behind a TLS cluster; %UPSTREAM_TRANSPORT_FAILURE_REASON% says which",
+			"A network policy or firewall between Envoy and the upstream",
+		},
+	},
+	{
+		Flag:        "UO",
+		Name:        "UpstreamOverflow",
+		Kind:        envoyKindUpstream,
+		Description: "A circuit breaker tripped: the cluster's connection, pending request or request limit was reached.",
+		Statuses:    []int{503},
+		Causes: []string{
+			"max_connections, max_pending_requests or max_requests of the cluster's circuit breakers is too low for the traffic",
+			"A slow upstream holds connections longer, so the limits fill up",
+		},
+	},
+	{
+		Flag:        "NR",
+		Name:        "NoRouteFound",
+		Kind:        envoyKindRouting,
+		Description: "No route matched the request, or no filter chain matched a downstream connection.",
+		Statuses:    []int{404},
+		Causes: []string{
+			"The Host/:authority header matches no virtual host, e.g. a missing port or domain",
+			"The path or headers match no route of the virtual host",
+			"The route configuration (RDS) was not delivered yet",
+		},
+	},
+	{
+		Flag:        "URX",
+		Name:        "UpstreamRetryLimitExceeded",
+		Kind:        envoyKindUpstream,
+		Description: "Every retry failed: the retry limit (HTTP) or maximum connect attempts (TCP) was reached.",
+		Statuses:    []int{503},
+		StatusNote:  "or the status of the last attempt",
+		Causes: []string{
+			"The upstream fails consistently, so retries only add latency; look at the flags of the attempts",
+			"The retry budget or max_retries circuit breaker is exhausted",
+		},
+	},
+	{
+		Flag:        "NC",
+		Name:        "NoClusterFound",
+		Kind:        envoyKindRouting,
+		Description: "The route points to an upstream cluster that does not exist.",
+		Statuses:    []int{503},
+		Causes: []string{
+			"The cluster (CDS) was not delivered yet, or was removed while routes still point to it",
+			"A typo in the route's cluster or cluster_header value",
+		},
+	},
+	{
+		Flag:        "DT",
+		Name:        "DurationTimeout",
+		Kind:        envoyKindTimeout,
+		Description: "The request or connection exceeded max_connection_duration or max_downstream_connection_duration.",
+		Statuses:    []int{408, 504},
+		Causes: []string{
+			"Long-lived streams (downloads, gRPC streams, WebSockets) outlast the configured maximum duration",
+		},
+	},
+	{
+		Flag:        "DC",
+		Name:        "DownstreamConnectionTermination",
+		Kind:        envoyKindDownstream,
+		Description: "The client closed the connection before the response was complete, like nginx's 499.",
+		StatusNote:  "often 0, as no response was sent",
+		Causes: []string{
+			"The client timed out or was cancelled before the upstream answered",
+			"A load balancer in front of Envoy has a shorter idle or request timeout",
+		},
+	},
+	{
+		Flag:        "LH",
+		Name:        "FailedLocalHealthCheck",
+		Kind:        envoyKindOverload,
+		Description: "The local service failed its health check, so Envoy answered the health check request itself.",
+		Statuses:    []int{503},
+		Causes: []string{
+			"The application behind the sidecar is unhealthy or not ready",
+			"The health check filter is in pass-through mode and the service reported failure",
+		},
+	},
+	{
+		Flag:        "UT",
+		Name:        "UpstreamRequestTimeout",
+		Kind:        envoyKindTimeout,
+		Description: "The upstream did not respond within the route timeout (15s by default).",
+		Statuses:    []int{504},
+		Causes: []string{
+			"The upstream is slow or stuck; compare %DURATION% with the route timeout",
+			"The route timeout, or x-envoy-upstream-rq-timeout-ms, is shorter than the operation needs",
+		},
+	},
+	{
+		Flag:        "LR",
+		Name:        "LocalReset",
+		Kind:        envoyKindUpstream,
+		Description: "Envoy reset the upstream stream or connection itself.",
+		Statuses:    []int{503},
+		Causes: []string{
+			"A filter or internal limit reset the stream, e.g. a buffer limit",
+			"Envoy is draining listeners during a hot restart or config change",
+		},
+	},
+	{
+		Flag:        "UR",
+		Name:        "UpstreamRemoteReset",
+		Kind:        envoyKindUpstream,
+		Description: "The upstream reset the connection or stream before responding.",
+		Statuses:    []int{503},
+		Causes: []string{
+			"The application crashed or was killed mid-request",
+			"An HTTP/2 upstream sent RST_STREAM, or a keepalive connection was closed by the upstream while reused",
+		},
+	},
+	{
+		Flag:        "UC",
+		Name:        "UpstreamConnectionTermination",
+		Kind:        envoyKindUpstream,
+		Description: "The upstream closed the connection before the response was complete.",
+		Statuses:    []int{503},
+		Causes: []string{
+			"The upstream's keepalive timeout is shorter than Envoy's idle_timeout, so reused connections are already closed",
+			"The application exited or restarted during the request",
+		},
+	},
+	{
+		Flag:        "DI",
+		Name:        "DelayInjected",
+		Kind:        envoyKindPolicy,
+		Description: "The request was delayed by the fault injection filter.",
+		StatusNote:  "any status; the delay only adds latency",
+		Causes: []string{
+			"A fault injection rule (e.g. an Istio VirtualService fault.delay) matched the request",
+		},
+	},
+	{
+		Flag:        "FI",
+		Name:        "FaultInjected",
+		Kind:        envoyKindPolicy,
+		Description: "The request was aborted by the fault injection filter with a configured status.",
+		StatusNote:  "the status configured in the abort rule",
+		Causes: []string{
+			"A fault injection rule (e.g. an Istio VirtualService fault.abort) matched the request; it may be a leftover chaos test",
+		},
+	},
+	{
+		Flag:        "RL",
+		Name:        "RateLimited",
+		Kind:        envoyKindPolicy,
+		Description: "The request was rate limited by the local or global HTTP rate limit filter.",
+		Statuses:    []int{429},
+		Causes: []string{
+			"The client exceeded a rate limit descriptor",
+			"Limits are too low for legitimate traffic, e.g. many users behind one NAT address",
+		},
+	},
+	{
+		Flag:        "UAEX",
+		Name:        "UnauthorizedExternalService",
+		Kind:        envoyKindPolicy,
+		Description: "The external authorization service denied the request.",
+		Statuses:    []int{403},
+		StatusNote:  "or the status set by the authorization service",
+		Causes: []string{
+			"The ext_authz service rejected the credentials or policy",
+			"The ext_authz service is unreachable and failure_mode_allow is false",
+		},
+	},
+	{
+		Flag:        "RLSE",
+		Name:        "RateLimitServiceError",
+		Kind:        envoyKindPolicy,
+		Description: "The rate limit service failed, and the filter is configured to reject requests on error.",
+		Statuses:    []int{500},
+		Causes: []string{
+			"The rate limit service is down or timed out while failure_mode_deny is true",
+		},
+	},
+	{
+		Flag:        "IH",
+		Name:        "InvalidEnvoyRequestHeaders",
+		Kind:        envoyKindDownstream,
+		Description: "The request set an invalid value for a strictly checked x-envoy-* header.",
+		Statuses:    []int{400},
+		Causes: []string{
+			"A client or upstream proxy sends malformed x-envoy-retry-on, x-envoy-max-retries or timeout headers",
+		},
+	},
+	{
+		Flag:        "SI",
+		Name:        "StreamIdleTimeout",
+		Kind:        envoyKindTimeout,
+		Description: "No data flowed on the stream for the stream idle timeout (5 minutes by default).",
+		Statuses:    []int{408, 504},
+		Causes: []string{
+			"Long polling, streaming or slow uploads that stay silent longer than stream_idle_timeout",
+			"An upstream that stops sending midway through a response",
+		},
+	},
+	{
+		Flag:        "DPE",
+		Name:        "DownstreamProtocolError",
+		Kind:        envoyKindDownstream,
+		Description: "The downstream request had an HTTP protocol error.",
+		Statuses:    []int{400},
+		Causes: []string{
+			"Malformed requests, invalid header characters or HTTP/2 framing errors from the client",
+			"Headers larger than max_request_headers_kb",
+		},
+	},
+	{
+		Flag:        "UPE",
+		Name:        "UpstreamProtocolError",
+		Kind:        envoyKindUpstream,
+		Description: "The upstream response had an HTTP protocol error.",
+		Statuses:    []int{502},
+		Causes: []string{
+			"The upstream speaks another protocol than the cluster expects, e.g. HTTP/1.1 to an HTTP/2 cluster",
+			"Invalid response headers, such as duplicate Content-Length or illegal characters",
+		},
+	},
+	{
+		Flag:        "UMSDR",
+		Name:        "UpstreamMaxStreamDurationReached",
+		Kind:        envoyKindTimeout,
+		Description: "The upstream request reached the route's max_stream_duration.",
+		Statuses:    []int{504},
+		Causes: []string{
+			"Streaming or long-running requests exceed max_stream_duration or grpc_timeout_header_max",
+		},
+	},
+	{
+		Flag:        "OM",
+		Name:        "OverloadManagerTerminated",
+		Kind:        envoyKindOverload,
+		Description: "The overload manager terminated the request because Envoy is short of resources.",
+		Statuses:    []int{503},
+		Causes: []string{
+			"Envoy's heap or active connections reached an overload action threshold",
+		},
+	},
+	{
+		Flag:        "DF",
+		Name:        "DnsResolutionFailed",
+		Kind:        envoyKindUpstream,
+		Description: "The request was terminated because the upstream host name could not be resolved.",
+		Statuses:    []int{503},
+		Causes: []string{
+			"A dynamic forward proxy or strict DNS cluster points to a name that does not resolve",
+		},
+	},
+	{
+		Flag:        "DO",
+		Name:        "DropOverload",
+		Kind:        envoyKindOverload,
+		Description: "The request was dropped by the cluster's drop_overloads setting.",
+		Statuses:    []int{503},
+		Causes: []string{
+			"The control plane asked Envoy to shed a share of the traffic",
+		},
+	},
+	{
+		Flag:        "UDO",
+		Name:        "UnconditionalDropOverload",
+		Kind:        envoyKindOverload,
+		Description: "The request was dropped because drop_overloads is set to 100%.",
+		Statuses:    []int{503},
+		Causes: []string{
+			"The control plane drains all traffic of the cluster",
+		},
+	},
+}
+
+// findEnvoyFlag looks up a response flag by its short or long name
+func findEnvoyFlag(name string) (EnvoyFlagInfo, bool) {
+	for _, info := range envoyFlagsInfo {
+		if strings.EqualFold(info.Flag, name) || strings.EqualFold(info.Name, name) {
+			return info, true
+		}
+	}
+	return EnvoyFlagInfo{}, false
+}
+
+// splitEnvoyFlags splits a response flags value such as "UF,URX"; "-" means
+// no flags
+func splitEnvoyFlags(flags string) []string {
+	var names []string
+	for _, flag := range strings.FieldsFunc(flags, func(r rune) bool {
+		return r == ',' || r == ' '
+	}) {
+		if flag != "-" {
+			names = append(names, flag)
+		}
+	}
+	return names
+}
+
+// describeEnvoyFlags explains each flag of a response flags value in one line
+func describeEnvoyFlags(flags string) string {
+	var parts []string
+	for _, flag := range splitEnvoyFlags(flags) {
+		if info, ok := findEnvoyFlag(flag); ok {
+			parts = append(parts, info.Flag+": "+info.Description)
+		} else {
+			parts = append(parts, flag+": unknown response flag.")
+		}
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,213 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// Fixed date used in sample responses so they are stable for fixtures
+const exampleDate = "Tue, 15 Nov 1994 08:12:31 GMT"
+
+// Body variants for sample responses and their media types
+var exampleBodyTypes = map[string]string{
+	"json":    "application/json",
+	"html":    "text/html; charset=utf-8",
+	"problem": "application/problem+json",
+}
+
+// exampleOptions controls how a sample response is rendered
+type exampleOptions struct {
+	HTTP2 bool
+	Body  string
+	CRLF  bool
+}
+
+// exampleHeader is a single header field of a sample response
+type exampleHeader struct {
+	Name  string
+	Value string
+}
+
+var exampleOpts exampleOptions
+
+// exampleCmd represents the example command
+var exampleCmd = &cobra.Command{
+	Use:   "example <code>",
+	Short: "Print a sample raw HTTP response for a status code",
+	Long: `Print a realistic raw HTTP/1.1 response for a status code: status line,
+the headers the status code requires, and a body (or deliberately no body for
+1xx, 204, 205 and 304 responses).
+
+Use --http2 to print the HTTP/2 form with the :status pseudo-header, and
+--body to choose between json, html and problem (RFC 9457) bodies.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		code, err := parseStatusCode(args[0])
+		if err != nil {
+			displayErrorWithLipgloss(err.Error())
+			return
+		}
+		response, err := buildExampleResponse(code, exampleOpts)
+		if err != nil {
+			displayErrorWithLipgloss(err.Error())
+			return
+		}
+		fmt.Print(response)
+	},
+}
+
+func init() {
+	exampleCmd.Flags().BoolVar(&exampleOpts.HTTP2, "http2", false, "print the HTTP/2 form with pseudo-headers")
+	exampleCmd.Flags().StringVar(&exampleOpts.Body, "body", "json", "body variant: json, html or problem")
+	exampleCmd.Flags().BoolVar(&exampleOpts.CRLF, "crlf", false, "terminate lines with CRLF as on the wire (HTTP/1.1 only)")
+	rootCmd.AddCommand(exampleCmd)
+}
+
+// parseStatusCode parses a registered HTTP status code argument
+func parseStatusCode(arg string) (int, error) {
+	code, err := strconv.Atoi(arg)
+	if err != nil {
+		return 0, fmt.Errorf("invalid HTTP status code %s", arg)
+	}
+	if _, exists := httpCodesInfo[code]; !exists {
+		return 0, fmt.Errorf("HTTP status code %d not found", code)
+	}
+	return code, nil
+}
+
+// exampleHasNoBody reports whether responses with this code never carry content
+func exampleHasNoBody(code int) bool {
+	return code < 200 || code == 204 || code == 205 || code == 304
+}
+
+// exampleStatusHeaders returns the headers a response with this code needs
+func exampleStatusHeaders(code int) []exampleHeader {
+	switch code {
+	case 101:
+		return []exampleHeader{{"Upgrade", "websocket"}, {"Connection", "Upgrade"}}
+	case 103:
+		return []exampleHeader{{"Link", "</style.css>; rel=preload; as=style"}}
+	case 201:
+		return []exampleHeader{{"Location", "/resources/42"}}
+	case 202:
+		return []exampleHeader{{"Location", "/jobs/7/status"}}
+	case 206:
+		return []exampleHeader{{"Content-Range", "bytes 0-99/1234"}}
+	case 300:
+		return []exampleHeader{{"Location", "/resources/42.json"}}
+	case 301, 302, 307, 308:
+		return []exampleHeader{{"Location", "https://example.com/new-location"}}
+	case 303:
+		return []exampleHeader{{"Location", "/resources/42"}}
+	case 304:
+		return []exampleHeader{{"ETag", "\"33a64df551425fcc55e4d42a148795d9f25f89d4\""}, {"Cache-Control", "max-age=3600"}}
+	case 305:
+		return []exampleHeader{{"Location", "http://proxy.example.com:8080/"}}
+	case 401:
+		return []exampleHeader{{"WWW-Authenticate", "Bearer realm=\"example\""}}
+	case 405:
+		return []exampleHeader{{"Allow", "GET, HEAD"}}
+	case 407:
+		return []exampleHeader{{"Proxy-Authenticate", "Basic realm=\"proxy\""}}
+	case 413, 429, 503:
+		return []exampleHeader{{"Retry-After", "120"}}
+	case 416:
+		return []exampleHeader{{"Content-Range", "bytes */1234"}}
+	case 426:
+		return []exampleHeader{{"Upgrade", "HTTP/2.0"}, {"Connection", "Upgrade"}}
+	}
+	return nil
+}
+
+// exampleBody renders the body of a sample response in the requested variant
+func exampleBody(code int, info HTTPCodeInfo, variant string) (string, error) {
+	switch variant {
+	case "json":
+		body := map[string]interface{}{"status": code, "message": info.Description}
+		if code >= 400 {
+			body = map[string]interface{}{"error": map[string]interface{}{"code": code, "message": info.Description}}
+		}
+		data, err := json.MarshalIndent(body, "", "  ")
+		return string(data) + "\n", err
+	case "html":
+		return fmt.Sprintf("<!DOCTYPE html>\n<html>\n<head><title>%d %s</title></head>\n<body>\n<h1>%s</h1>\n<p>%s</p>\n</body>\n</html>\n",
+			code, html.EscapeString(info.Description), html.EscapeString(info.Description), html.EscapeString(info.Detail)), nil
+	case "problem":
+		if code < 400 {
+			return "", fmt.Errorf("problem bodies are only defined for 4xx and 5xx responses")
+		}
+		doc := newProblemDocument(code)
+		doc.Detail = info.Detail
+		return renderProblemJSON(doc)
+	}
+	return "", fmt.Errorf("invalid body variant %s. Use json, html or problem", variant)
+}
+
+// buildExampleResponse renders a sample raw response for a registered status code
+func buildExampleResponse(code int, opts exampleOptions) (string, error) {
+	info, exists := httpCodesInfo[code]
+	if !exists {
+		return "", fmt.Errorf("HTTP status code %d not found", code)
+	}
+	if opts.HTTP2 && code == 101 {
+		return "", fmt.Errorf("101 Switching Protocols is not used in HTTP/2, which has no Upgrade mechanism")
+	}
+
+	// Interim 1xx responses carry only the fields they are about
+	var headers []exampleHeader
+	if code >= 200 {
+		headers = append(headers, exampleHeader{"Date", exampleDate})
+	}
+
+	body := ""
+	if code == 206 {
+		// A partial response carries the requested byte range, not a document
+		body = strings.Repeat("x", 100)
+		headers = append(headers,
+			exampleHeader{"Content-Type", "text/plain; charset=utf-8"},
+			exampleHeader{"Content-Length", fmt.Sprint(len(body))})
+	} else if !exampleHasNoBody(code) {
+		contentType, valid := exampleBodyTypes[opts.Body]
+		if !valid {
+			return "", fmt.Errorf("invalid body variant %s. Use json, html or problem", opts.Body)
+		}
+		var err error
+		if body, err = exampleBody(code, info, opts.Body); err != nil {
+			return "", err
+		}
+		headers = append(headers,
+			exampleHeader{"Content-Type", contentType},
+			exampleHeader{"Content-Length", fmt.Sprint(len(body))})
+	} else if code == 205 {
+		headers = append(headers, exampleHeader{"Content-Length", "0"})
+	}
+	headers = append(headers, exampleStatusHeaders(code)...)
+
+	var lines []string
+	if opts.HTTP2 {
+		// Connection-specific header fields are not allowed in HTTP/2
+		lines = append(lines, fmt.Sprintf(":status: %d", code))
+		for _, h := range headers {
+			if h.Name == "Connection" || h.Name == "Upgrade" {
+				continue
+			}
+			lines = append(lines, fmt.Sprintf("%s: %s", strings.ToLower(h.Name), h.Value))
+		}
+	} else {
+		lines = append(lines, fmt.Sprintf("HTTP/1.1 %d %s", code, info.Description))
+		for _, h := range headers {
+			lines = append(lines, fmt.Sprintf("%s: %s", h.Name, h.Value))
+		}
+	}
+
+	eol := "\n"
+	if opts.CRLF && !opts.HTTP2 {
+		eol = "\r\n"
+	}
+	return strings.Join(lines, eol) + eol + eol + body, nil
+}
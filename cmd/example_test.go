@@ -0,0 +1,194 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestBuildExampleResponse(t *testing.T) {
+	tests := []struct {
+		name            string
+		code            int
+		opts            exampleOptions
+		wantContains    []string
+		wantNotContains []string
+		wantErr         bool
+	}{
+		{
+			name: "json error body",
+			code: 404,
+			opts: exampleOptions{Body: "json"},
+			wantContains: []string{
+				"HTTP/1.1 404 Not Found\n",
+				"Content-Type: application/json\n",
+				"\"message\": \"Not Found\"",
+			},
+		},
+		{
+			name: "created has location",
+			code: 201,
+			opts: exampleOptions{Body: "json"},
+			wantContains: []string{
+				"HTTP/1.1 201 Created",
+				"Location: /resources/42",
+			},
+		},
+		{
+			name: "no content has no body",
+			code: 204,
+			opts: exampleOptions{Body: "json"},
+			wantContains: []string{
+				"HTTP/1.1 204 No Content\n",
+			},
+			wantNotContains: []string{
+				"Content-Length",
+				"Content-Type",
+			},
+		},
+		{
+			name: "not modified has validators",
+			code: 304,
+			opts: exampleOptions{Body: "html"},
+			wantContains: []string{
+				"ETag:",
+			},
+			wantNotContains: []string{
+				"<html>",
+			},
+		},
+		{
+			name: "html body",
+			code: 503,
+			opts: exampleOptions{Body: "html"},
+			wantContains: []string{
+				"Content-Type: text/html; charset=utf-8",
+				"Retry-After: 120",
+				"<title>503 Service Unavailable</title>",
+			},
+		},
+		{
+			name: "html body is escaped",
+			code: 418,
+			opts: exampleOptions{Body: "html"},
+			wantContains: []string{
+				"<title>418 I&#39;m a teapot</title>",
+			},
+			wantNotContains: []string{
+				"I'm a teapot</h1>",
+			},
+		},
+		{
+			name: "problem body",
+			code: 429,
+			opts: exampleOptions{Body: "problem"},
+			wantContains: []string{
+				"Content-Type: application/problem+json",
+				"\"title\": \"Too Many Requests\"",
+				"\"status\": 429",
+			},
+		},
+		{
+			name: "http2 pseudo header",
+			code: 426,
+			opts: exampleOptions{Body: "json", HTTP2: true},
+			wantContains: []string{
+				":status: 426\n",
+				"content-type: application/json",
+			},
+			wantNotContains: []string{
+				"HTTP/1.1",
+				"connection:",
+				"upgrade:",
+			},
+		},
+		{
+			name: "crlf line endings",
+			code: 200,
+			opts: exampleOptions{Body: "json", CRLF: true},
+			wantContains: []string{
+				"HTTP/1.1 200 OK\r\n",
+				"\r\n\r\n{",
+			},
+		},
+		{
+			name:    "problem body for success code",
+			code:    200,
+			opts:    exampleOptions{Body: "problem"},
+			wantErr: true,
+		},
+		{
+			name:    "switching protocols over http2",
+			code:    101,
+			opts:    exampleOptions{Body: "json", HTTP2: true},
+			wantErr: true,
+		},
+		{
+			name:    "invalid body variant",
+			code:    200,
+			opts:    exampleOptions{Body: "xml"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown code",
+			code:    299,
+			opts:    exampleOptions{Body: "json"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := buildExampleResponse(tt.code, tt.opts)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error for code %d, got response: %s", tt.code, response)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			for _, want := range tt.wantContains {
+				if !strings.Contains(response, want) {
+					t.Errorf("Expected '%s' in response, got: %s", want, response)
+				}
+			}
+
+			for _, unwanted := range tt.wantNotContains {
+				if strings.Contains(response, unwanted) {
+					t.Errorf("Did not expect '%s' in response, got: %s", unwanted, response)
+				}
+			}
+		})
+	}
+}
+
+func TestExampleContentLength(t *testing.T) {
+	for code := range httpCodesInfo {
+		t.Run(fmt.Sprintf("code_%d", code), func(t *testing.T) {
+			response, err := buildExampleResponse(code, exampleOptions{Body: "json"})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			parts := strings.SplitN(response, "\n\n", 2)
+			body := parts[1]
+			if exampleHasNoBody(code) {
+				if body != "" {
+					t.Errorf("Code %d should have no body, got: %s", code, body)
+				}
+				return
+			}
+
+			if !strings.Contains(parts[0], fmt.Sprintf("Content-Length: %d", len(body))) {
+				t.Errorf("Code %d Content-Length does not match body length %d: %s", code, len(body), parts[0])
+			}
+			if code != 206 && !json.Valid([]byte(body)) {
+				t.Errorf("Code %d body is not valid JSON: %s", code, body)
+			}
+		})
+	}
+}
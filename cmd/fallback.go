@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Link to the status code section that defines class-level fallback
+const statusCodeClassesLink = "https://www.rfc-editor.org/rfc/rfc9110#section-15"
+
+// How common clients handle an unrecognized code of each class
+var classFallbackBehaviors = map[int]string{
+	1: "A client must be able to parse 1xx responses it does not expect and may ignore them while waiting for the final response.",
+	2: "fetch() sets response.ok for any code from 200 to 299, so the response is handled as a success.",
+	3: "Browsers only follow Location for 301, 302, 303, 307 and 308; for other 3xx codes they show the response body instead.",
+	4: "The client seems to have erred and should not repeat the request unchanged; retry policies treat it as a permanent failure.",
+	5: "Retry policies typically retry it like other 5xx responses, but only for idempotent requests.",
+}
+
+// unofficialStatusCode is an unregistered code that a server or CDN uses
+type unofficialStatusCode struct {
+	Name string
+	Note string
+}
+
+// Unregistered codes common in access logs, with the names their users give
+// them
+var unofficialStatusCodes = map[int]unofficialStatusCode{
+	444: {"No Response", "nginx closes the connection without a response and logs 444; the client sees no status at all."},
+	494: {"Request Header Too Large", "nginx logs 494 when the request headers exceed large_client_header_buffers, and sends 400."},
+	495: {"SSL Certificate Error", "nginx logs 495 when the client certificate fails verification, and sends 400."},
+	496: {"SSL Certificate Required", "nginx logs 496 when a required client certificate is missing, and sends 400."},
+	497: {"HTTP Request Sent to HTTPS Port", "nginx logs 497 for plain HTTP on an HTTPS port, and sends 400."},
+	499: {"Client Closed Request", "nginx logs 499 when the client closed the connection before the response was sent; it never reaches the client."},
+	520: {"Web Server Returned an Unknown Error", "Cloudflare sends 520 when the origin returned an empty, unknown or unexpected response."},
+	521: {"Web Server Is Down", "Cloudflare sends 521 when the origin refused the connection."},
+	522: {"Connection Timed Out", "Cloudflare sends 522 when the TCP connection to the origin timed out."},
+	523: {"Origin Is Unreachable", "Cloudflare sends 523 when it cannot reach the origin, e.g. because of DNS or routing."},
+	524: {"A Timeout Occurred", "Cloudflare sends 524 when the origin accepted the connection but did not respond in time."},
+	525: {"SSL Handshake Failed", "Cloudflare sends 525 when the TLS handshake with the origin failed."},
+	526: {"Invalid SSL Certificate", "Cloudflare sends 526 when the origin's certificate could not be validated."},
+}
+
+// isStatusCodeInRange reports whether a code is in the valid range 100-599
+func isStatusCodeInRange(code int) bool {
+	return code >= 100 && code <= 599
+}
+
+// classFallbackCode returns the x00 code an unrecognized code is treated as
+func classFallbackCode(code int) int {
+	return code / 100 * 100
+}
+
+// invalidStatusCodeReason explains why a code outside 100-599 is not a valid
+// HTTP status code
+func invalidStatusCodeReason(code int) string {
+	switch {
+	case code < 0:
+		return fmt.Sprintf("%d is negative. Status codes are three-digit integers from 100 to 599 (RFC 9110, Section 15).", code)
+	case code < 100:
+		return fmt.Sprintf("%d has fewer than three digits. Status codes are three-digit integers from 100 to 599, and the first digit is the class (RFC 9110, Section 15).", code)
+	case code < 1000:
+		return fmt.Sprintf("%d is outside 100-599, so there is no %dxx class. RFC 9110 says clients should handle such a response like a 5xx, and libraries sometimes use 600-999 internally for non-HTTP errors.", code, code/100)
+	default:
+		return fmt.Sprintf("%d has more than three digits. The status line carries exactly three digits (RFC 9112, Section 4), so a response with this code cannot be parsed.", code)
+	}
+}
+
+// displayUnregisteredCodeWithLipgloss displays the class-level fallback for
+// an unregistered code in 100-599
+func displayUnregisteredCodeWithLipgloss(code int) {
+	fallback := classFallbackCode(code)
+	notes := []string{
+		classFallbackBehaviors[code/100],
+		"Go's http.StatusText returns \"\" for it, and Python's requests sets response.reason to whatever phrase the server sent; both pass the code through unchanged.",
+	}
+	if unofficial, exists := unofficialStatusCodes[code]; exists {
+		notes = append(notes, fmt.Sprintf("Known as %d %s. %s", code, unofficial.Name, unofficial.Note))
+	}
+	if revisions, exists := statusCodeLineage[code]; exists {
+		latest := revisions[len(revisions)-1]
+		notes = append(notes, fmt.Sprintf("Listed as %q in %s; see 'httpcode history %d'", latest.Name, latest.Spec, code))
+	}
+
+	displayEntryWithLipgloss(displayEntry{
+		Title:       fmt.Sprintf("HTTP %d (unregistered)", code),
+		Class:       getStatusCodeCategory(code),
+		Color:       getStatusCodeColor(code),
+		Description: fmt.Sprintf("Not a registered status code. Clients must treat an unrecognized code as the x00 code of its class, so %d is handled as %d %s.", code, fallback, httpCodesInfo[fallback].Description),
+		Notes:       notes,
+		Link:        statusCodeClassesLink,
+	})
+}
+
+// displayInvalidCodeWithLipgloss explains why a code is not a valid HTTP
+// status code
+func displayInvalidCodeWithLipgloss(code int) {
+	message := lipgloss.NewStyle().
+		Foreground(redirectionColor).
+		Render(fmt.Sprintf("⚠️  Invalid:     %s", invalidStatusCodeReason(code)))
+	fmt.Println(message)
+}
@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestClassFallbackCodes(t *testing.T) {
+	for class := 1; class <= 5; class++ {
+		if _, exists := httpCodesInfo[class*100]; !exists {
+			t.Errorf("Fallback code %d is not registered", class*100)
+		}
+		if classFallbackBehaviors[class] == "" {
+			t.Errorf("Class %dxx has no client behavior note", class)
+		}
+	}
+}
+
+func TestLookupUnregisteredCode(t *testing.T) {
+	tests := []struct {
+		name            string
+		code            int
+		wantContains    []string
+		wantNotContains []string
+	}{
+		{
+			name: "unregistered client error",
+			code: 499,
+			wantContains: []string{
+				"HTTP 499 (unregistered)",
+				"Client Error",
+				"499 is handled as 400 Bad Request",
+				"Known as 499 Client Closed Request",
+				"should not repeat the request unchanged",
+				"whatever phrase the server sent",
+				"rfc9110#section-15",
+			},
+			wantNotContains: []string{"not found"},
+		},
+		{
+			name: "unregistered redirect",
+			code: 399,
+			wantContains: []string{
+				"399 is handled as 300 Multiple Choices",
+				"Browsers only follow Location",
+			},
+		},
+		{
+			name: "reserved code with history",
+			code: 306,
+			wantContains: []string{
+				"HTTP 306 (unregistered)",
+				`Listed as "(Unused)" in RFC 9110`,
+				"httpcode history 306",
+			},
+		},
+		{
+			name:         "lower bound",
+			code:         100,
+			wantContains: []string{"HTTP 100 Continue"},
+		},
+		{
+			name: "upper bound",
+			code: 599,
+			wantContains: []string{
+				"HTTP 599 (unregistered)",
+				"599 is handled as 500 Internal Server Error",
+			},
+		},
+		{
+			name: "too few digits",
+			code: 99,
+			wantContains: []string{
+				"HTTP status code 99 not found",
+				"99 has fewer than three digits",
+			},
+		},
+		{
+			name: "no such class",
+			code: 600,
+			wantContains: []string{
+				"HTTP status code 600 not found",
+				"there is no 6xx class",
+				"like a 5xx",
+			},
+		},
+		{
+			name: "too many digits",
+			code: 1000,
+			wantContains: []string{
+				"HTTP status code 1000 not found",
+				"1000 has more than three digits",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout, _ := captureOutput(func() {
+				lookupCode(tt.code)
+			})
+
+			for _, want := range tt.wantContains {
+				if !strings.Contains(stdout, want) {
+					t.Errorf("Expected '%s' in output, got: %s", want, stdout)
+				}
+			}
+			for _, unwanted := range tt.wantNotContains {
+				if strings.Contains(stdout, unwanted) {
+					t.Errorf("Expected no '%s' in output, got: %s", unwanted, stdout)
+				}
+			}
+		})
+	}
+}
+
+func TestInvalidStatusCodeReason(t *testing.T) {
+	tests := []struct {
+		code int
+		want string
+	}{
+		{-1, "is negative"},
+		{0, "fewer than three digits"},
+		{99, "fewer than three digits"},
+		{999, "no 9xx class"},
+		{12345, "more than three digits"},
+	}
+
+	for _, tt := range tests {
+		if got := invalidStatusCodeReason(tt.code); !strings.Contains(got, tt.want) {
+			t.Errorf("invalidStatusCodeReason(%d) = %q, want it to contain %q", tt.code, got, tt.want)
+		}
+	}
+}
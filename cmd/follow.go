@@ -0,0 +1,194 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"os"
+)
+
+// logFollower reads the lines appended to a log file, like tail -F: it
+// reopens the file when it is rotated and rewinds when it is truncated
+type logFollower struct {
+	path    string
+	current *followedFile
+	// The file before the last rotation, read for one more poll since
+	// writers keep appending to it until they reopen their log
+	rotated *followedFile
+}
+
+// followedFile is one open file of a followed log
+type followedFile struct {
+	file    *os.File
+	info    os.FileInfo
+	reader  *bufio.Reader
+	offset  int64
+	partial []byte
+	// Whether the unterminated line is longer than maxLogLineSize
+	tooLong bool
+}
+
+// openLogFollower opens a log file to follow, from its start or its end. A
+// file that does not exist yet is waited for, and read from its start once
+// it appears.
+func openLogFollower(path string, fromStart bool) (*logFollower, error) {
+	f := &logFollower{path: path}
+	file, err := openFollowedFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return f, nil
+	} else if err != nil {
+		return nil, err
+	}
+	f.current = file
+	if !fromStart {
+		offset, err := file.file.Seek(0, io.SeekEnd)
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		file.offset = offset
+		file.reader.Reset(file.file)
+	}
+	return f, nil
+}
+
+func openFollowedFile(path string) (*followedFile, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &followedFile{file: file, info: info, reader: bufio.NewReaderSize(file, 64*1024)}, nil
+}
+
+// Close closes the followed files
+func (f *logFollower) Close() error {
+	if f.rotated != nil {
+		f.rotated.file.Close()
+	}
+	if f.current == nil {
+		return nil
+	}
+	return f.current.file.Close()
+}
+
+// readLines calls fn for every complete line appended since the last call.
+// A rotated file is read for one more poll, then to its end, before it is
+// closed. It returns the number of lines dropped for being longer than
+// maxLogLineSize.
+func (f *logFollower) readLines(fn func(line string)) (int, error) {
+	oversized := 0
+	if f.rotated != nil {
+		n, err := f.rotated.drain(fn)
+		oversized += n
+		if err != nil {
+			return oversized, err
+		}
+		// The last line of a rotated file needs no trailing newline
+		oversized += f.rotated.flush(fn)
+		f.rotated.file.Close()
+		f.rotated = nil
+	}
+
+	if f.current == nil {
+		// Waiting for the file to be created
+		file, err := openFollowedFile(f.path)
+		if errors.Is(err, os.ErrNotExist) {
+			return oversized, nil
+		} else if err != nil {
+			return oversized, err
+		}
+		f.current = file
+	}
+	n, err := f.current.drain(fn)
+	oversized += n
+	if err != nil {
+		return oversized, err
+	}
+
+	info, err := os.Stat(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		// Between the rename and the creation of the new file
+		return oversized, nil
+	}
+	if err != nil {
+		return oversized, err
+	}
+
+	switch {
+	case !os.SameFile(info, f.current.info):
+		f.rotated, f.current = f.current, nil
+		file, err := openFollowedFile(f.path)
+		if errors.Is(err, os.ErrNotExist) {
+			return oversized, nil
+		} else if err != nil {
+			return oversized, err
+		}
+		f.current = file
+	case info.Size() < f.current.offset:
+		if _, err := f.current.file.Seek(0, io.SeekStart); err != nil {
+			return oversized, err
+		}
+		f.current.offset, f.current.partial, f.current.tooLong = 0, nil, false
+		f.current.reader.Reset(f.current.file)
+	default:
+		return oversized, nil
+	}
+	n, err = f.current.drain(fn)
+	return oversized + n, err
+}
+
+// drain reads a file to its end, keeping an unterminated last line until the
+// rest of it is written. Lines longer than maxLogLineSize are dropped; it
+// returns how many.
+func (s *followedFile) drain(fn func(line string)) (int, error) {
+	oversized := 0
+	for {
+		chunk, err := s.reader.ReadSlice('\n')
+		s.offset += int64(len(chunk))
+		s.appendPartial(chunk)
+		if err == bufio.ErrBufferFull {
+			continue
+		}
+		if err == io.EOF {
+			return oversized, nil
+		}
+		if err != nil {
+			return oversized, err
+		}
+		oversized += s.flush(fn)
+	}
+}
+
+// appendPartial adds a chunk to the unterminated line, only buffering it up
+// to the limit
+func (s *followedFile) appendPartial(chunk []byte) {
+	if s.tooLong {
+		return
+	}
+	s.partial = append(s.partial, chunk...)
+	if len(s.partial) > maxLogLineSize+len("\r\n") {
+		s.partial, s.tooLong = nil, true
+	}
+}
+
+// flush passes on the buffered line, if any, and returns 1 if it was
+// dropped for its length
+func (s *followedFile) flush(fn func(line string)) int {
+	line := bytes.TrimRight(s.partial, "\r\n")
+	tooLong := s.tooLong || len(line) > maxLogLineSize
+	empty := len(s.partial) == 0
+	s.partial, s.tooLong = s.partial[:0], false
+	switch {
+	case tooLong:
+		return 1
+	case !empty:
+		fn(string(line))
+	}
+	return 0
+}
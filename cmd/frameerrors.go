@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Error code spaces for HTTP/2 and HTTP/3
+const (
+	frameErrorSpaceH2    = "HTTP/2"
+	frameErrorSpaceH3    = "HTTP/3"
+	frameErrorSpaceQPACK = "QPACK"
+)
+
+// FrameErrorCodeInfo contains information about an HTTP/2 or HTTP/3 error code,
+// as carried in RST_STREAM, GOAWAY, RESET_STREAM and CONNECTION_CLOSE frames
+type FrameErrorCodeInfo struct {
+	Code        uint64
+	Name        string
+	Space       string
+	Description string
+	Section     string
+	Link        string
+	// Whether the request is known not to have been processed and may be retried
+	Retryable bool
+}
+
+// HTTP/2 error codes (RFC 9113, Section 7)
+var http2ErrorCodesInfo = []FrameErrorCodeInfo{
+	{0x0, "NO_ERROR", frameErrorSpaceH2,
+		"The associated condition is not a result of an error, e.g. a GOAWAY sent for a graceful shutdown.",
+		"RFC 9113, Section 7", "https://www.rfc-editor.org/rfc/rfc9113#section-7", false},
+	{0x1, "PROTOCOL_ERROR", frameErrorSpaceH2,
+		"The endpoint detected an unspecific protocol error. Used when a more specific error code is not available.",
+		"RFC 9113, Section 7", "https://www.rfc-editor.org/rfc/rfc9113#section-7", false},
+	{0x2, "INTERNAL_ERROR", frameErrorSpaceH2,
+		"The endpoint encountered an unexpected internal error.",
+		"RFC 9113, Section 7", "https://www.rfc-editor.org/rfc/rfc9113#section-7", false},
+	{0x3, "FLOW_CONTROL_ERROR", frameErrorSpaceH2,
+		"The endpoint detected that its peer violated the flow-control protocol.",
+		"RFC 9113, Section 6.9", "https://www.rfc-editor.org/rfc/rfc9113#section-6.9", false},
+	{0x4, "SETTINGS_TIMEOUT", frameErrorSpaceH2,
+		"The endpoint sent a SETTINGS frame but did not receive a response in a timely manner.",
+		"RFC 9113, Section 6.5.3", "https://www.rfc-editor.org/rfc/rfc9113#section-6.5.3", false},
+	{0x5, "STREAM_CLOSED", frameErrorSpaceH2,
+		"The endpoint received a frame after a stream was half-closed.",
+		"RFC 9113, Section 5.1", "https://www.rfc-editor.org/rfc/rfc9113#section-5.1", false},
+	{0x6, "FRAME_SIZE_ERROR", frameErrorSpaceH2,
+		"The endpoint received a frame with an invalid size.",
+		"RFC 9113, Section 4.2", "https://www.rfc-editor.org/rfc/rfc9113#section-4.2", false},
+	{0x7, "REFUSED_STREAM", frameErrorSpaceH2,
+		"The endpoint refused the stream prior to performing any application processing.",
+		"RFC 9113, Section 8.7", "https://www.rfc-editor.org/rfc/rfc9113#section-8.7", true},
+	{0x8, "CANCEL", frameErrorSpaceH2,
+		"The endpoint indicates that the stream is no longer needed, e.g. the client navigated away or the request timed out.",
+		"RFC 9113, Section 7", "https://www.rfc-editor.org/rfc/rfc9113#section-7", false},
+	{0x9, "COMPRESSION_ERROR", frameErrorSpaceH2,
+		"The endpoint is unable to maintain the field section compression context (HPACK) for the connection.",
+		"RFC 9113, Section 4.3", "https://www.rfc-editor.org/rfc/rfc9113#section-4.3", false},
+	{0xa, "CONNECT_ERROR", frameErrorSpaceH2,
+		"The connection established in response to a CONNECT request was reset or abnormally closed.",
+		"RFC 9113, Section 8.5", "https://www.rfc-editor.org/rfc/rfc9113#section-8.5", false},
+	{0xb, "ENHANCE_YOUR_CALM", frameErrorSpaceH2,
+		"The endpoint detected that its peer is exhibiting a behavior that might be generating excessive load.",
+		"RFC 9113, Section 10.5", "https://www.rfc-editor.org/rfc/rfc9113#section-10.5", false},
+	{0xc, "INADEQUATE_SECURITY", frameErrorSpaceH2,
+		"The underlying transport has properties that do not meet minimum security requirements, e.g. the TLS version or cipher suite.",
+		"RFC 9113, Section 9.2", "https://www.rfc-editor.org/rfc/rfc9113#section-9.2", false},
+	{0xd, "HTTP_1_1_REQUIRED", frameErrorSpaceH2,
+		"The endpoint requires that HTTP/1.1 be used instead of HTTP/2.",
+		"RFC 9113, Section 7", "https://www.rfc-editor.org/rfc/rfc9113#section-7", true},
+}
+
+// HTTP/3 (RFC 9114, Section 8.1) and QPACK (RFC 9204, Section 6) error codes
+var http3ErrorCodesInfo = []FrameErrorCodeInfo{
+	{0x100, "H3_NO_ERROR", frameErrorSpaceH3,
+		"No error. Used when the connection or stream needs to be closed, but there is no error to signal.",
+		"RFC 9114, Section 8.1", "https://www.rfc-editor.org/rfc/rfc9114#section-8.1", false},
+	{0x101, "H3_GENERAL_PROTOCOL_ERROR", frameErrorSpaceH3,
+		"Peer violated protocol requirements in a way that does not match a more specific error code.",
+		"RFC 9114, Section 8.1", "https://www.rfc-editor.org/rfc/rfc9114#section-8.1", false},
+	{0x102, "H3_INTERNAL_ERROR", frameErrorSpaceH3,
+		"An internal error has occurred in the HTTP stack.",
+		"RFC 9114, Section 8.1", "https://www.rfc-editor.org/rfc/rfc9114#section-8.1", false},
+	{0x103, "H3_STREAM_CREATION_ERROR", frameErrorSpaceH3,
+		"The endpoint detected that its peer created a stream that it will not accept.",
+		"RFC 9114, Section 8.1", "https://www.rfc-editor.org/rfc/rfc9114#section-8.1", false},
+	{0x104, "H3_CLOSED_CRITICAL_STREAM", frameErrorSpaceH3,
+		"A stream required by the HTTP/3 connection was closed or reset.",
+		"RFC 9114, Section 6.2.1", "https://www.rfc-editor.org/rfc/rfc9114#section-6.2.1", false},
+	{0x105, "H3_FRAME_UNEXPECTED", frameErrorSpaceH3,
+		"A frame was received that is not permitted in the current state or on the current stream.",
+		"RFC 9114, Section 7.1", "https://www.rfc-editor.org/rfc/rfc9114#section-7.1", false},
+	{0x106, "H3_FRAME_ERROR", frameErrorSpaceH3,
+		"A frame that fails to satisfy layout requirements or with an invalid size was received.",
+		"RFC 9114, Section 7.1", "https://www.rfc-editor.org/rfc/rfc9114#section-7.1", false},
+	{0x107, "H3_EXCESSIVE_LOAD", frameErrorSpaceH3,
+		"The endpoint detected that its peer is exhibiting a behavior that might be generating excessive load.",
+		"RFC 9114, Section 10.5", "https://www.rfc-editor.org/rfc/rfc9114#section-10.5", false},
+	{0x108, "H3_ID_ERROR", frameErrorSpaceH3,
+		"A stream ID or push ID was used incorrectly, such as exceeding a limit, reducing a limit, or being reused.",
+		"RFC 9114, Section 8.1", "https://www.rfc-editor.org/rfc/rfc9114#section-8.1", false},
+	{0x109, "H3_SETTINGS_ERROR", frameErrorSpaceH3,
+		"An endpoint detected an error in the payload of a SETTINGS frame.",
+		"RFC 9114, Section 7.2.4", "https://www.rfc-editor.org/rfc/rfc9114#section-7.2.4", false},
+	{0x10a, "H3_MISSING_SETTINGS", frameErrorSpaceH3,
+		"No SETTINGS frame was received at the beginning of the control stream.",
+		"RFC 9114, Section 6.2.1", "https://www.rfc-editor.org/rfc/rfc9114#section-6.2.1", false},
+	{0x10b, "H3_REQUEST_REJECTED", frameErrorSpaceH3,
+		"A server rejected a request without performing any application processing.",
+		"RFC 9114, Section 4.1.1", "https://www.rfc-editor.org/rfc/rfc9114#section-4.1.1", true},
+	{0x10c, "H3_REQUEST_CANCELLED", frameErrorSpaceH3,
+		"The request or its response (including pushed response) is cancelled.",
+		"RFC 9114, Section 4.1.1", "https://www.rfc-editor.org/rfc/rfc9114#section-4.1.1", false},
+	{0x10d, "H3_REQUEST_INCOMPLETE", frameErrorSpaceH3,
+		"The client's stream terminated without containing a fully formed request.",
+		"RFC 9114, Section 4.1", "https://www.rfc-editor.org/rfc/rfc9114#section-4.1", false},
+	{0x10e, "H3_MESSAGE_ERROR", frameErrorSpaceH3,
+		"An HTTP message was malformed and cannot be processed.",
+		"RFC 9114, Section 4.1.2", "https://www.rfc-editor.org/rfc/rfc9114#section-4.1.2", false},
+	{0x10f, "H3_CONNECT_ERROR", frameErrorSpaceH3,
+		"The TCP connection established in response to a CONNECT request was reset or abnormally closed.",
+		"RFC 9114, Section 4.4", "https://www.rfc-editor.org/rfc/rfc9114#section-4.4", false},
+	{0x110, "H3_VERSION_FALLBACK", frameErrorSpaceH3,
+		"The requested operation cannot be served over HTTP/3. The peer should retry over HTTP/1.1.",
+		"RFC 9114, Section 8.1", "https://www.rfc-editor.org/rfc/rfc9114#section-8.1", true},
+	{0x200, "QPACK_DECOMPRESSION_FAILED", frameErrorSpaceQPACK,
+		"The decoder failed to interpret an encoded field section and is not able to continue decoding that field section.",
+		"RFC 9204, Section 6", "https://www.rfc-editor.org/rfc/rfc9204#section-6", false},
+	{0x201, "QPACK_ENCODER_STREAM_ERROR", frameErrorSpaceQPACK,
+		"The decoder failed to interpret an encoder instruction received on the encoder stream.",
+		"RFC 9204, Section 6", "https://www.rfc-editor.org/rfc/rfc9204#section-6", false},
+	{0x202, "QPACK_DECODER_STREAM_ERROR", frameErrorSpaceQPACK,
+		"The encoder failed to interpret a decoder instruction received on the decoder stream.",
+		"RFC 9204, Section 6", "https://www.rfc-editor.org/rfc/rfc9204#section-6", false},
+}
+
+// findFrameErrorCode looks up an error code by number (decimal or 0x hex) or by
+// name in any casing. For HTTP/3 the H3_ prefix may be omitted.
+func findFrameErrorCode(codes []FrameErrorCodeInfo, arg string) (FrameErrorCodeInfo, bool) {
+	if number, err := strconv.ParseUint(arg, 0, 64); err == nil {
+		for _, info := range codes {
+			if info.Code == number {
+				return info, true
+			}
+		}
+		return FrameErrorCodeInfo{}, false
+	}
+
+	name := strings.ToUpper(strings.ReplaceAll(arg, "-", "_"))
+	for _, info := range codes {
+		if info.Name == name || info.Name == "H3_"+name {
+			return info, true
+		}
+	}
+	return FrameErrorCodeInfo{}, false
+}
+
+// isHTTP3GreaseCode reports whether an error code is reserved for greasing
+// (0x1f * N + 0x21, RFC 9114, Section 8.1)
+func isHTTP3GreaseCode(code uint64) bool {
+	return code >= 0x21 && (code-0x21)%0x1f == 0
+}
@@ -0,0 +1,305 @@
+package cmd
+
+import (
+	"fmt"
+	"go/format"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/spf13/cobra"
+)
+
+// Generators for status code source files, keyed by target language
+var codeGenerators = map[string]func(b *strings.Builder, codes []int, pkg string){
+	"go":      generateGo,
+	"ts":      generateTypeScript,
+	"python":  generatePython,
+	"java":    generateJava,
+	"rust":    generateRust,
+	"openapi": generateOpenAPI,
+}
+
+var (
+	genLang    string
+	genPackage string
+	genOutput  string
+)
+
+// genCmd represents the gen command
+var genCmd = &cobra.Command{
+	Use:   "gen",
+	Short: "Generate status code constants for other languages",
+	Long: `Generate a source file of status code constants, reason phrases, class
+helpers and doc comments from the same table the CLI shows, so services in
+other languages share exactly the same definitions.
+
+Supported targets: go, ts, python, java, rust, openapi`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		source, err := generateCodeSource(genLang, genPackage)
+		if err != nil {
+			displayErrorWithLipgloss(err.Error())
+			return
+		}
+		if genOutput == "" {
+			fmt.Print(source)
+			return
+		}
+		if err := os.WriteFile(genOutput, []byte(source), 0o644); err != nil {
+			displayErrorWithLipgloss(err.Error())
+		}
+	},
+}
+
+func init() {
+	genCmd.Flags().StringVar(&genLang, "lang", "", "target: go, ts, python, java, rust or openapi")
+	genCmd.Flags().StringVar(&genPackage, "package", "httpstatus", "package or module name for go and java")
+	genCmd.Flags().StringVarP(&genOutput, "output", "o", "", "write to a file instead of stdout")
+	genCmd.MarkFlagRequired("lang")
+	rootCmd.AddCommand(genCmd)
+}
+
+// registryCodes returns every status code in the registry in ascending order
+func registryCodes() []int {
+	var codes []int
+	for code := range httpCodesInfo {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+	return codes
+}
+
+// generateCodeSource renders the registry as source code for a target language
+func generateCodeSource(lang, pkg string) (string, error) {
+	generator, exists := codeGenerators[strings.ToLower(lang)]
+	if !exists {
+		var targets []string
+		for target := range codeGenerators {
+			targets = append(targets, target)
+		}
+		sort.Strings(targets)
+		return "", fmt.Errorf("unknown target %s. Use %s", lang, strings.Join(targets, ", "))
+	}
+
+	var b strings.Builder
+	generator(&b, registryCodes(), pkg)
+	if strings.ToLower(lang) != "go" {
+		return b.String(), nil
+	}
+
+	// Align the generated Go source the way gofmt does
+	source, err := format.Source([]byte(b.String()))
+	if err != nil {
+		return "", fmt.Errorf("cannot format generated Go source: %v", err)
+	}
+	return string(source), nil
+}
+
+// identifierWords splits a reason phrase into identifier words
+func identifierWords(phrase string) []string {
+	phrase = strings.ReplaceAll(phrase, "'", "")
+	return strings.FieldsFunc(phrase, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// pascalIdentifier turns a reason phrase into PascalCase, keeping acronyms ("HTTPVersionNotSupported")
+func pascalIdentifier(phrase string) string {
+	var b strings.Builder
+	for _, word := range identifierWords(phrase) {
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return b.String()
+}
+
+// upperSnakeIdentifier turns a reason phrase into UPPER_SNAKE_CASE ("TOO_MANY_REQUESTS")
+func upperSnakeIdentifier(phrase string) string {
+	return strings.ToUpper(strings.Join(identifierWords(phrase), "_"))
+}
+
+// statusClassNames are the class helper names in class order (1xx..5xx)
+var statusClassNames = []string{"Informational", "Success", "Redirection", "ClientError", "ServerError"}
+
+func generateGo(b *strings.Builder, codes []int, pkg string) {
+	fmt.Fprintf(b, "// Code generated by httpcode gen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(b, "// Package %s defines HTTP status codes and their reason phrases.\n", pkg)
+	fmt.Fprintf(b, "package %s\n\n", pkg)
+	b.WriteString("// HTTP status codes\nconst (\n")
+	for _, code := range codes {
+		info := httpCodesInfo[code]
+		fmt.Fprintf(b, "\t// Status%s is %d %s: %s\n", pascalIdentifier(info.Description), code, info.Description, info.Detail)
+		fmt.Fprintf(b, "\tStatus%s = %d\n", pascalIdentifier(info.Description), code)
+	}
+	b.WriteString(")\n\n")
+
+	b.WriteString("var reasonPhrases = map[int]string{\n")
+	for _, code := range codes {
+		fmt.Fprintf(b, "\tStatus%s: %s,\n", pascalIdentifier(httpCodesInfo[code].Description), strconv.Quote(httpCodesInfo[code].Description))
+	}
+	b.WriteString("}\n\n")
+
+	b.WriteString("// ReasonPhrase returns the reason phrase for a status code, or \"\" if it is unknown.\n")
+	b.WriteString("func ReasonPhrase(code int) string {\n\treturn reasonPhrases[code]\n}\n")
+	for i, class := range statusClassNames {
+		fmt.Fprintf(b, "\n// Is%s reports whether code is a %dxx status code.\n", class, i+1)
+		fmt.Fprintf(b, "func Is%s(code int) bool {\n\treturn code >= %d && code < %d\n}\n", class, (i+1)*100, (i+2)*100)
+	}
+}
+
+func generateTypeScript(b *strings.Builder, codes []int, pkg string) {
+	b.WriteString("// Code generated by httpcode gen. DO NOT EDIT.\n\n")
+	b.WriteString("/** HTTP status codes. */\nexport const HttpStatus = {\n")
+	for _, code := range codes {
+		info := httpCodesInfo[code]
+		fmt.Fprintf(b, "  /** %d %s: %s */\n", code, info.Description, info.Detail)
+		fmt.Fprintf(b, "  %s: %d,\n", upperSnakeIdentifier(info.Description), code)
+	}
+	b.WriteString("} as const;\n\n")
+	b.WriteString("export type HttpStatus = (typeof HttpStatus)[keyof typeof HttpStatus];\n\n")
+
+	b.WriteString("/** Reason phrases by status code. */\nexport const reasonPhrases: Readonly<Record<number, string>> = {\n")
+	for _, code := range codes {
+		fmt.Fprintf(b, "  %d: %s,\n", code, strconv.Quote(httpCodesInfo[code].Description))
+	}
+	b.WriteString("};\n")
+	for i, class := range statusClassNames {
+		fmt.Fprintf(b, "\n/** Reports whether code is a %dxx status code. */\n", i+1)
+		fmt.Fprintf(b, "export function is%s(code: number): boolean {\n  return code >= %d && code < %d;\n}\n", class, (i+1)*100, (i+2)*100)
+	}
+}
+
+func generatePython(b *strings.Builder, codes []int, pkg string) {
+	b.WriteString("# Code generated by httpcode gen. DO NOT EDIT.\n")
+	b.WriteString("\"\"\"HTTP status codes and their reason phrases.\"\"\"\n\n")
+	b.WriteString("from enum import IntEnum\n\n\n")
+	b.WriteString("class HTTPStatus(IntEnum):\n    \"\"\"HTTP status codes.\"\"\"\n\n")
+	for _, code := range codes {
+		info := httpCodesInfo[code]
+		fmt.Fprintf(b, "    %s = %d\n", upperSnakeIdentifier(info.Description), code)
+		fmt.Fprintf(b, "    \"\"\"%s\"\"\"\n\n", strings.ReplaceAll(info.Detail, "\"", "'"))
+	}
+	b.WriteString("    @property\n    def phrase(self) -> str:\n        \"\"\"The reason phrase of the status code.\"\"\"\n")
+	b.WriteString("        return REASON_PHRASES[self.value]\n\n\n")
+
+	b.WriteString("REASON_PHRASES = {\n")
+	for _, code := range codes {
+		fmt.Fprintf(b, "    %d: %s,\n", code, strconv.Quote(httpCodesInfo[code].Description))
+	}
+	b.WriteString("}\n")
+	for i, class := range statusClassNames {
+		name := strings.ToLower(upperSnakeIdentifier(strings.Join(splitPascal(class), " ")))
+		fmt.Fprintf(b, "\n\ndef is_%s(code: int) -> bool:\n", name)
+		fmt.Fprintf(b, "    \"\"\"Report whether code is a %dxx status code.\"\"\"\n", i+1)
+		fmt.Fprintf(b, "    return %d <= code < %d\n", (i+1)*100, (i+2)*100)
+	}
+}
+
+func generateJava(b *strings.Builder, codes []int, pkg string) {
+	b.WriteString("// Code generated by httpcode gen. DO NOT EDIT.\n")
+	fmt.Fprintf(b, "package %s;\n\n", pkg)
+	b.WriteString("/** HTTP status codes and their reason phrases. */\npublic enum HttpStatusCode {\n")
+	for i, code := range codes {
+		info := httpCodesInfo[code]
+		separator := ","
+		if i == len(codes)-1 {
+			separator = ";"
+		}
+		fmt.Fprintf(b, "    /** %d %s: %s */\n", code, info.Description, info.Detail)
+		fmt.Fprintf(b, "    %s(%d, %s)%s\n", upperSnakeIdentifier(info.Description), code, strconv.Quote(info.Description), separator)
+	}
+	b.WriteString(`
+    private final int code;
+    private final String reasonPhrase;
+
+    HttpStatusCode(int code, String reasonPhrase) {
+        this.code = code;
+        this.reasonPhrase = reasonPhrase;
+    }
+
+    /** Returns the numeric status code. */
+    public int code() {
+        return code;
+    }
+
+    /** Returns the reason phrase. */
+    public String reasonPhrase() {
+        return reasonPhrase;
+    }
+
+    /** Returns the status with the given code, or null if it is unknown. */
+    public static HttpStatusCode valueOf(int code) {
+        for (HttpStatusCode status : values()) {
+            if (status.code == code) {
+                return status;
+            }
+        }
+        return null;
+    }
+`)
+	for i, class := range statusClassNames {
+		fmt.Fprintf(b, "\n    /** Reports whether this is a %dxx status code. */\n", i+1)
+		fmt.Fprintf(b, "    public boolean is%s() {\n        return code >= %d && code < %d;\n    }\n", class, (i+1)*100, (i+2)*100)
+	}
+	b.WriteString("}\n")
+}
+
+func generateRust(b *strings.Builder, codes []int, pkg string) {
+	b.WriteString("// Code generated by httpcode gen. DO NOT EDIT.\n")
+	b.WriteString("//! HTTP status codes and their reason phrases.\n\n")
+	for _, code := range codes {
+		info := httpCodesInfo[code]
+		fmt.Fprintf(b, "/// %d %s: %s\n", code, info.Description, info.Detail)
+		fmt.Fprintf(b, "pub const %s: u16 = %d;\n", upperSnakeIdentifier(info.Description), code)
+	}
+
+	b.WriteString("\n/// Returns the reason phrase for a status code, if it is known.\n")
+	b.WriteString("pub fn reason_phrase(code: u16) -> Option<&'static str> {\n    match code {\n")
+	for _, code := range codes {
+		fmt.Fprintf(b, "        %d => Some(%s),\n", code, strconv.Quote(httpCodesInfo[code].Description))
+	}
+	b.WriteString("        _ => None,\n    }\n}\n")
+	for i, class := range statusClassNames {
+		name := strings.ToLower(upperSnakeIdentifier(strings.Join(splitPascal(class), " ")))
+		fmt.Fprintf(b, "\n/// Reports whether code is a %dxx status code.\n", i+1)
+		fmt.Fprintf(b, "pub fn is_%s(code: u16) -> bool {\n    (%d..%d).contains(&code)\n}\n", name, (i+1)*100, (i+2)*100)
+	}
+}
+
+func generateOpenAPI(b *strings.Builder, codes []int, pkg string) {
+	b.WriteString("# Code generated by httpcode gen. DO NOT EDIT.\n")
+	b.WriteString("components:\n  schemas:\n    HttpStatusCode:\n")
+	b.WriteString("      type: integer\n      description: HTTP status code\n      enum:\n")
+	for _, code := range codes {
+		fmt.Fprintf(b, "        - %d\n", code)
+	}
+	b.WriteString("      x-enum-varnames:\n")
+	for _, code := range codes {
+		fmt.Fprintf(b, "        - %s\n", upperSnakeIdentifier(httpCodesInfo[code].Description))
+	}
+	b.WriteString("      x-enum-descriptions:\n")
+	for _, code := range codes {
+		fmt.Fprintf(b, "        - %s\n", strconv.Quote(httpCodesInfo[code].Description))
+	}
+	b.WriteString("  responses:\n")
+	for _, code := range codes {
+		info := httpCodesInfo[code]
+		fmt.Fprintf(b, "    %s:\n", pascalIdentifier(info.Description))
+		fmt.Fprintf(b, "      description: %s\n", strconv.Quote(fmt.Sprintf("%d %s. %s", code, info.Description, info.Detail)))
+	}
+}
+
+// splitPascal splits a PascalCase identifier into words ("ClientError" -> "Client", "Error")
+func splitPascal(identifier string) []string {
+	var words []string
+	start := 0
+	for i, r := range identifier {
+		if i > 0 && unicode.IsUpper(r) {
+			words = append(words, identifier[start:i])
+			start = i
+		}
+	}
+	return append(words, identifier[start:])
+}
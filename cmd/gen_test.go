@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"go/format"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+func TestIdentifiers(t *testing.T) {
+	tests := []struct {
+		phrase     string
+		pascal     string
+		upperSnake string
+	}{
+		{"OK", "OK", "OK"},
+		{"Too Many Requests", "TooManyRequests", "TOO_MANY_REQUESTS"},
+		{"I'm a teapot", "ImATeapot", "IM_A_TEAPOT"},
+		{"Non-Authoritative Information", "NonAuthoritativeInformation", "NON_AUTHORITATIVE_INFORMATION"},
+		{"HTTP Version Not Supported", "HTTPVersionNotSupported", "HTTP_VERSION_NOT_SUPPORTED"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.phrase, func(t *testing.T) {
+			if got := pascalIdentifier(tt.phrase); got != tt.pascal {
+				t.Errorf("pascalIdentifier(%q) = %q, want %q", tt.phrase, got, tt.pascal)
+			}
+			if got := upperSnakeIdentifier(tt.phrase); got != tt.upperSnake {
+				t.Errorf("upperSnakeIdentifier(%q) = %q, want %q", tt.phrase, got, tt.upperSnake)
+			}
+		})
+	}
+}
+
+func TestGenerateCodeSource(t *testing.T) {
+	tests := []struct {
+		lang         string
+		wantContains []string
+	}{
+		{"go", []string{"package httpstatus", "StatusTooManyRequests = 429", "func IsClientError(code int) bool"}},
+		{"ts", []string{"TOO_MANY_REQUESTS: 429,", "429: \"Too Many Requests\",", "export function isServerError"}},
+		{"python", []string{"class HTTPStatus(IntEnum):", "TOO_MANY_REQUESTS = 429", "def is_client_error(code: int) -> bool:"}},
+		{"java", []string{"package httpstatus;", "TOO_MANY_REQUESTS(429, \"Too Many Requests\"),", "public boolean isRedirection()"}},
+		{"rust", []string{"pub const TOO_MANY_REQUESTS: u16 = 429;", "429 => Some(\"Too Many Requests\"),", "pub fn is_informational(code: u16) -> bool"}},
+		{"openapi", []string{"HttpStatusCode:", "        - 429", "    TooManyRequests:"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang, func(t *testing.T) {
+			source, err := generateCodeSource(tt.lang, "httpstatus")
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !strings.HasPrefix(source, "# Code generated by httpcode gen. DO NOT EDIT.") &&
+				!strings.HasPrefix(source, "// Code generated by httpcode gen. DO NOT EDIT.") {
+				t.Errorf("Expected generated header, got: %s", source[:80])
+			}
+
+			for _, want := range tt.wantContains {
+				if !strings.Contains(source, want) {
+					t.Errorf("Expected '%s' in %s source", want, tt.lang)
+				}
+			}
+
+			// Every registered code must be present
+			for code := range httpCodesInfo {
+				if !strings.Contains(source, httpCodesInfo[code].Description) {
+					t.Errorf("Expected %d %s in %s source", code, httpCodesInfo[code].Description, tt.lang)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateGoSourceParses(t *testing.T) {
+	source, err := generateCodeSource("go", "httpstatus")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, err := parser.ParseFile(token.NewFileSet(), "status.go", source, parser.ParseComments); err != nil {
+		t.Errorf("Generated Go source does not parse: %v", err)
+	}
+}
+
+func TestGenerateGoSourceIsFormatted(t *testing.T) {
+	source, err := generateCodeSource("go", "httpstatus")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	formatted, err := format.Source([]byte(source))
+	if err != nil {
+		t.Fatalf("Generated Go source does not format: %v", err)
+	}
+	if string(formatted) != source {
+		t.Errorf("Generated Go source is not gofmt-clean")
+	}
+}
+
+func TestGenerateCodeSourceUnknownTarget(t *testing.T) {
+	if _, err := generateCodeSource("cobol", "httpstatus"); err == nil {
+		t.Error("Expected error for unknown target")
+	}
+}
@@ -0,0 +1,231 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+// Color used for gRPC status codes
+var grpcColor = lipgloss.Color("#2980b9")
+
+// GRPCCodeInfo contains information about a canonical gRPC status code
+type GRPCCodeInfo struct {
+	Code        int
+	Name        string
+	Description string
+	HTTPStatus  int
+	Note        string
+}
+
+// Canonical gRPC status codes and the HTTP status grpc-gateway maps them to
+var grpcCodesInfo = []GRPCCodeInfo{
+	{0, "OK", "Not an error; returned on success.", 200, ""},
+	{1, "CANCELLED", "The operation was cancelled, typically by the caller.", 499,
+		"499 Client Closed Request is a non-standard code popularized by nginx; it never reaches the client that cancelled."},
+	{2, "UNKNOWN", "Unknown error, e.g. a status from another address space or an error without enough information.", 500, ""},
+	{3, "INVALID_ARGUMENT", "The client specified an invalid argument, regardless of the state of the system.", 400, ""},
+	{4, "DEADLINE_EXCEEDED", "The deadline expired before the operation could complete; the operation may still have succeeded.", 504, ""},
+	{5, "NOT_FOUND", "Some requested entity (e.g. file or directory) was not found.", 404, ""},
+	{6, "ALREADY_EXISTS", "The entity that a client attempted to create already exists.", 409, ""},
+	{7, "PERMISSION_DENIED", "The caller does not have permission to execute the specified operation.", 403, ""},
+	{8, "RESOURCE_EXHAUSTED", "Some resource has been exhausted, such as a per-user quota or the file system being out of space.", 429, ""},
+	{9, "FAILED_PRECONDITION", "The system is not in a state required for the operation's execution; the client should not retry until the state is fixed.", 400,
+		"Google API guidelines map this to 400 rather than 412, which is reserved for conditional request headers."},
+	{10, "ABORTED", "The operation was aborted, typically due to a concurrency issue such as a transaction abort; retry at a higher level.", 409, ""},
+	{11, "OUT_OF_RANGE", "The operation was attempted past the valid range, e.g. seeking or reading past end-of-file.", 400, ""},
+	{12, "UNIMPLEMENTED", "The operation is not implemented or is not supported/enabled in this service.", 501, ""},
+	{13, "INTERNAL", "Internal error: some invariant expected by the underlying system has been broken.", 500, ""},
+	{14, "UNAVAILABLE", "The service is currently unavailable; this is most likely transient and can be retried with backoff.", 503, ""},
+	{15, "DATA_LOSS", "Unrecoverable data loss or corruption.", 500, ""},
+	{16, "UNAUTHENTICATED", "The request does not have valid authentication credentials for the operation.", 401, ""},
+}
+
+// gRPC code a client reports when it receives a non-gRPC HTTP response
+// (grpc/doc/http-grpc-status-mapping.md); all other statuses become UNKNOWN
+var httpToGRPCClientCodes = map[int]int{
+	400: 13, // INTERNAL
+	401: 16, // UNAUTHENTICATED
+	403: 7,  // PERMISSION_DENIED
+	404: 12, // UNIMPLEMENTED
+	429: 14, // UNAVAILABLE
+	502: 14, // UNAVAILABLE
+	503: 14, // UNAVAILABLE
+	504: 14, // UNAVAILABLE
+}
+
+const grpcStatusDocsLink = "https://grpc.io/docs/guides/status-codes/"
+
+// grpcCmd represents the grpc command
+var grpcCmd = &cobra.Command{
+	Use:   "grpc [code|name]",
+	Short: "Map gRPC status codes to HTTP status codes",
+	Long: `Look up a canonical gRPC status code by number or name (e.g. 14, UNAVAILABLE,
+FailedPrecondition) and show how it maps to HTTP through grpc-gateway, and what a
+gRPC client reports when it receives the HTTP status directly.
+
+Running 'httpcode grpc' without arguments lists all gRPC status codes.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 {
+			listGRPCCodes()
+			return
+		}
+		lookupGRPCCode(args[0])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(grpcCmd)
+}
+
+// findGRPCCode looks up a gRPC code by number or by name in any casing
+func findGRPCCode(arg string) (GRPCCodeInfo, bool) {
+	if number, err := strconv.Atoi(arg); err == nil {
+		for _, info := range grpcCodesInfo {
+			if info.Code == number {
+				return info, true
+			}
+		}
+		return GRPCCodeInfo{}, false
+	}
+
+	normalized := strings.ToUpper(strings.ReplaceAll(strings.TrimPrefix(arg, "codes."), "_", ""))
+	for _, info := range grpcCodesInfo {
+		if strings.ReplaceAll(info.Name, "_", "") == normalized {
+			return info, true
+		}
+	}
+	return GRPCCodeInfo{}, false
+}
+
+// grpcCodesForHTTP returns the gRPC codes grpc-gateway maps to an HTTP status
+func grpcCodesForHTTP(status int) []GRPCCodeInfo {
+	var matches []GRPCCodeInfo
+	for _, info := range grpcCodesInfo {
+		if info.HTTPStatus == status {
+			matches = append(matches, info)
+		}
+	}
+	return matches
+}
+
+// hasGRPCMapping reports whether a gRPC code maps to or from a status
+func hasGRPCMapping(status int) bool {
+	_, exists := httpToGRPCClientCodes[status]
+	return exists || len(grpcCodesForHTTP(status)) > 0
+}
+
+// grpcClientCodeForHTTP returns the gRPC code a client reports for a raw HTTP status
+func grpcClientCodeForHTTP(status int) GRPCCodeInfo {
+	code, exists := httpToGRPCClientCodes[status]
+	if !exists {
+		code = 2 // UNKNOWN
+	}
+	return grpcCodesInfo[code]
+}
+
+// formatGRPCNames formats gRPC codes as "NAME (n)" pairs
+func formatGRPCNames(codes []GRPCCodeInfo) string {
+	var names []string
+	for _, info := range codes {
+		names = append(names, fmt.Sprintf("%s (%d)", info.Name, info.Code))
+	}
+	return strings.Join(names, ", ")
+}
+
+// formatHTTPStatus formats an HTTP status with its description, even if unregistered
+func formatHTTPStatus(status int) string {
+	if unofficial, exists := unofficialStatusCodes[status]; exists {
+		return fmt.Sprintf("%d %s", status, unofficial.Name)
+	}
+	return formatRelatedCodes([]int{status})
+}
+
+func lookupGRPCCode(arg string) {
+	info, exists := findGRPCCode(arg)
+	if !exists {
+		displayErrorWithLipgloss(fmt.Sprintf("gRPC status code %s not found", arg))
+		return
+	}
+	displayGRPCCodeWithLipgloss(info)
+}
+
+func listGRPCCodes() {
+	displayListHeaderWithLipgloss("gRPC Status Codes")
+	for _, info := range grpcCodesInfo {
+		name := lipgloss.NewStyle().
+			Foreground(grpcColor).
+			Render(fmt.Sprintf("  %2d %-20s", info.Code, info.Name))
+		status := lipgloss.NewStyle().
+			Foreground(getStatusCodeColor(info.HTTPStatus)).
+			Render(formatHTTPStatus(info.HTTPStatus))
+		fmt.Printf("%s → %s\n", name, status)
+	}
+}
+
+// displayGRPCCodeWithLipgloss displays a gRPC status code and its HTTP mappings
+func displayGRPCCodeWithLipgloss(info GRPCCodeInfo) {
+	header := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(grpcColor).
+		Render(fmt.Sprintf("           gRPC %d %s", info.Code, info.Name))
+	fmt.Println(header)
+
+	fmt.Printf("📝 Description: %s\n", info.Description)
+
+	status := lipgloss.NewStyle().
+		Foreground(getStatusCodeColor(info.HTTPStatus)).
+		Render(formatHTTPStatus(info.HTTPStatus))
+	fmt.Printf("🌐 HTTP:        %s (grpc-gateway)\n", status)
+	if info.Note != "" {
+		fmt.Printf("💡 Note:        %s\n", info.Note)
+	}
+
+	// Several gRPC codes share one HTTP status, so the reverse mapping loses information
+	if shared := grpcCodesForHTTP(info.HTTPStatus); len(shared) > 1 {
+		displayGRPCLossyWithLipgloss(fmt.Sprintf("%d is shared by %s; the original gRPC code cannot be recovered from HTTP alone.",
+			info.HTTPStatus, formatGRPCNames(shared)))
+	}
+	if back := grpcClientCodeForHTTP(info.HTTPStatus); back.Code != info.Code && info.Code != 0 {
+		displayGRPCLossyWithLipgloss(fmt.Sprintf("a gRPC client receiving a raw HTTP %d (e.g. from a proxy) reports %s, not %s.",
+			info.HTTPStatus, back.Name, info.Name))
+	}
+
+	link := lipgloss.NewStyle().
+		Foreground(linkColor).
+		Render(fmt.Sprintf("🔗 Docs:        %s", grpcStatusDocsLink))
+	fmt.Println(link)
+
+	fmt.Println()
+}
+
+// displayGRPCMapping displays the gRPC codes related to an HTTP status code
+func displayGRPCMapping(status int) {
+	fromGateway := grpcCodesForHTTP(status)
+	if len(fromGateway) > 0 {
+		names := lipgloss.NewStyle().Foreground(grpcColor).Render(formatGRPCNames(fromGateway))
+		fmt.Printf("🔌 gRPC:        %s → %d (grpc-gateway)\n", names, status)
+	} else {
+		fmt.Printf("🔌 gRPC:        no gRPC code maps to %d (grpc-gateway)\n", status)
+	}
+
+	back := grpcClientCodeForHTTP(status)
+	client := lipgloss.NewStyle().Foreground(grpcColor).Render(fmt.Sprintf("%s (%d)", back.Name, back.Code))
+	fmt.Printf("   Client:      a gRPC client receiving a raw %d reports %s\n", status, client)
+
+	if len(fromGateway) > 1 {
+		displayGRPCLossyWithLipgloss(fmt.Sprintf("%d is shared by several gRPC codes; the original code cannot be recovered from HTTP alone.", status))
+	}
+}
+
+// displayGRPCLossyWithLipgloss calls out a lossy mapping between gRPC and HTTP
+func displayGRPCLossyWithLipgloss(message string) {
+	lossy := lipgloss.NewStyle().
+		Foreground(redirectionColor).
+		Render(fmt.Sprintf("⚠️  Lossy:       %s", message))
+	fmt.Println(lossy)
+}
@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestGRPCCodesInfoStructure(t *testing.T) {
+	for i, info := range grpcCodesInfo {
+		t.Run(fmt.Sprintf("grpc_%d_structure", info.Code), func(t *testing.T) {
+			if info.Code != i {
+				t.Errorf("gRPC code %s is at index %d, expected %d", info.Name, i, info.Code)
+			}
+
+			if info.Name == "" || info.Description == "" {
+				t.Errorf("gRPC code %d missing Name or Description", info.Code)
+			}
+
+			if _, exists := httpCodesInfo[info.HTTPStatus]; !exists && info.HTTPStatus != 499 {
+				t.Errorf("gRPC code %s maps to unknown status code %d", info.Name, info.HTTPStatus)
+			}
+		})
+	}
+}
+
+func TestFindGRPCCode(t *testing.T) {
+	tests := []struct {
+		arg      string
+		wantName string
+		wantOK   bool
+	}{
+		{"14", "UNAVAILABLE", true},
+		{"UNAVAILABLE", "UNAVAILABLE", true},
+		{"failed_precondition", "FAILED_PRECONDITION", true},
+		{"FailedPrecondition", "FAILED_PRECONDITION", true},
+		{"codes.DeadlineExceeded", "DEADLINE_EXCEEDED", true},
+		{"17", "", false},
+		{"NOPE", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			info, ok := findGRPCCode(tt.arg)
+			if ok != tt.wantOK {
+				t.Fatalf("findGRPCCode(%q) found = %v, expected %v", tt.arg, ok, tt.wantOK)
+			}
+			if ok && info.Name != tt.wantName {
+				t.Errorf("findGRPCCode(%q) = %s, expected %s", tt.arg, info.Name, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestCanonicalGRPCMapping(t *testing.T) {
+	tests := []struct {
+		grpc string
+		http int
+	}{
+		{"UNAVAILABLE", 503},
+		{"FAILED_PRECONDITION", 400},
+		{"DEADLINE_EXCEEDED", 504},
+		{"UNAUTHENTICATED", 401},
+		{"RESOURCE_EXHAUSTED", 429},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.grpc, func(t *testing.T) {
+			info, _ := findGRPCCode(tt.grpc)
+			if info.HTTPStatus != tt.http {
+				t.Errorf("%s maps to %d, expected %d", tt.grpc, info.HTTPStatus, tt.http)
+			}
+		})
+	}
+
+	if back := grpcClientCodeForHTTP(418); back.Name != "UNKNOWN" {
+		t.Errorf("Unmapped HTTP status should be reported as UNKNOWN, got %s", back.Name)
+	}
+}
+
+func TestLookupGRPCCode(t *testing.T) {
+	tests := []struct {
+		name         string
+		arg          string
+		wantContains []string
+	}{
+		{
+			name: "round trip",
+			arg:  "UNAVAILABLE",
+			wantContains: []string{
+				"gRPC 14 UNAVAILABLE",
+				"503 Service Unavailable",
+			},
+		},
+		{
+			name: "shared http status",
+			arg:  "FAILED_PRECONDITION",
+			wantContains: []string{
+				"400 Bad Request",
+				"Lossy",
+				"INVALID_ARGUMENT (3)",
+				"reports INTERNAL",
+			},
+		},
+		{
+			name: "client sees a different code",
+			arg:  "5",
+			wantContains: []string{
+				"404 Not Found",
+				"reports UNIMPLEMENTED, not NOT_FOUND",
+			},
+		},
+		{
+			name: "not found",
+			arg:  "42",
+			wantContains: []string{
+				"gRPC status code 42 not found",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout, _ := captureOutput(func() {
+				lookupGRPCCode(tt.arg)
+			})
+
+			for _, want := range tt.wantContains {
+				if !strings.Contains(stdout, want) {
+					t.Errorf("Expected '%s' in output, got: %s", want, stdout)
+				}
+			}
+		})
+	}
+}
+
+func TestDisplayGRPCMapping(t *testing.T) {
+	stdout, _ := captureOutput(func() {
+		displayGRPCMapping(500)
+	})
+
+	for _, want := range []string{"UNKNOWN (2)", "INTERNAL (13)", "DATA_LOSS (15)", "Lossy"} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("Expected '%s' in output, got: %s", want, stdout)
+		}
+	}
+}
+
+func TestLookupGRPCFlagUnregisteredCode(t *testing.T) {
+	defer func() { lookupGRPCFlag = false }()
+
+	tests := []struct {
+		name         string
+		args         []string
+		wantContains []string
+		wantMissing  []string
+	}{
+		{
+			name:         "cancelled maps to 499",
+			args:         []string{"499", "--grpc"},
+			wantContains: []string{"HTTP 499 (unregistered)", "CANCELLED (1) → 499 (grpc-gateway)", "raw 499 reports UNKNOWN (2)"},
+		},
+		{
+			name:        "no mapping",
+			args:        []string{"498", "--grpc"},
+			wantMissing: []string{"gRPC:"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lookupGRPCFlag = false
+			stdout, _ := captureOutput(func() {
+				rootCmd.SetArgs(tt.args)
+				rootCmd.Execute()
+			})
+			for _, want := range tt.wantContains {
+				if !strings.Contains(stdout, want) {
+					t.Errorf("Expected '%s' in output, got: %s", want, stdout)
+				}
+			}
+			for _, missing := range tt.wantMissing {
+				if strings.Contains(stdout, missing) {
+					t.Errorf("Expected '%s' not in output, got: %s", missing, stdout)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+// Frames that carry error codes in each error code space
+var frameErrorSpaceClasses = map[string]string{
+	frameErrorSpaceH2:    "HTTP/2 Error (RST_STREAM, GOAWAY)",
+	frameErrorSpaceH3:    "HTTP/3 Error (RESET_STREAM, STOP_SENDING, CONNECTION_CLOSE)",
+	frameErrorSpaceQPACK: "QPACK Error (CONNECTION_CLOSE)",
+}
+
+// h2Cmd represents the h2 command
+var h2Cmd = &cobra.Command{
+	Use:   "h2 [code|name]",
+	Short: "Look up HTTP/2 error codes",
+	Long: `Look up HTTP/2 error codes as seen in RST_STREAM and GOAWAY frames, by number
+(7, 0x7) or name (REFUSED_STREAM, refused-stream).
+
+Running 'httpcode h2' without arguments lists all HTTP/2 error codes.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 {
+			listFrameErrorCodes("HTTP/2 Error Codes", http2ErrorCodesInfo)
+			return
+		}
+		lookupFrameErrorCode(frameErrorSpaceH2, http2ErrorCodesInfo, args[0])
+	},
+}
+
+// h3Cmd represents the h3 command
+var h3Cmd = &cobra.Command{
+	Use:   "h3 [code|name]",
+	Short: "Look up HTTP/3 and QPACK error codes",
+	Long: `Look up HTTP/3 and QPACK error codes as seen in RESET_STREAM, STOP_SENDING
+and CONNECTION_CLOSE frames, by number (0x10b, 267) or name (H3_REQUEST_REJECTED,
+request_rejected, QPACK_DECOMPRESSION_FAILED).
+
+Running 'httpcode h3' without arguments lists all HTTP/3 and QPACK error codes.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 {
+			listFrameErrorCodes("HTTP/3 and QPACK Error Codes", http3ErrorCodesInfo)
+			return
+		}
+		lookupFrameErrorCode(frameErrorSpaceH3, http3ErrorCodesInfo, args[0])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(h2Cmd)
+	rootCmd.AddCommand(h3Cmd)
+}
+
+// lookupFrameErrorCode looks up an HTTP/2 or HTTP/3 error code
+func lookupFrameErrorCode(space string, codes []FrameErrorCodeInfo, arg string) {
+	if info, exists := findFrameErrorCode(codes, arg); exists {
+		displayFrameErrorCodeWithLipgloss(info)
+		return
+	}
+
+	if number, err := strconv.ParseUint(arg, 0, 64); err == nil && space == frameErrorSpaceH3 && isHTTP3GreaseCode(number) {
+		displayEntryWithLipgloss(displayEntry{
+			Title:       fmt.Sprintf("HTTP/3 0x%x (reserved)", number),
+			Class:       frameErrorSpaceClasses[frameErrorSpaceH3],
+			Color:       unknownColor,
+			Description: "Reserved to exercise the requirement that unknown error codes be treated as equivalent to H3_NO_ERROR. Endpoints send these to grease the protocol.",
+			Link:        "https://www.rfc-editor.org/rfc/rfc9114#section-8.1",
+		})
+		return
+	}
+
+	displayErrorWithLipgloss(fmt.Sprintf("%s error code %s not found", space, arg))
+}
+
+// getFrameErrorCodeColor returns the color for an error code: green for no
+// error, orange for retryable errors and red otherwise
+func getFrameErrorCodeColor(info FrameErrorCodeInfo) lipgloss.Color {
+	switch {
+	case info.Name == "NO_ERROR" || info.Name == "H3_NO_ERROR":
+		return successColor
+	case info.Retryable:
+		return redirectionColor
+	default:
+		return clientErrorColor
+	}
+}
+
+func listFrameErrorCodes(title string, codes []FrameErrorCodeInfo) {
+	displayListHeaderWithLipgloss(title)
+	for _, info := range codes {
+		item := lipgloss.NewStyle().
+			Foreground(getFrameErrorCodeColor(info)).
+			Render(fmt.Sprintf("  0x%-4x %-28s", info.Code, info.Name))
+		section := lipgloss.NewStyle().
+			Foreground(mutedColor).
+			Render(info.Section)
+		fmt.Println(item + " " + section)
+	}
+}
+
+// displayFrameErrorCodeWithLipgloss displays an HTTP/2 or HTTP/3 error code using Lipgloss styling
+func displayFrameErrorCodeWithLipgloss(info FrameErrorCodeInfo) {
+	var notes []string
+	switch {
+	case info.Name == "HTTP_1_1_REQUIRED" || info.Name == "H3_VERSION_FALLBACK":
+		notes = append(notes, "Retry the request over HTTP/1.1.")
+	case info.Retryable:
+		notes = append(notes, "The request was not processed and can be retried safely, even if it is not idempotent.")
+	}
+
+	displayEntryWithLipgloss(displayEntry{
+		Title:       fmt.Sprintf("%s 0x%x %s", info.Space, info.Code, info.Name),
+		Class:       frameErrorSpaceClasses[info.Space],
+		Color:       getFrameErrorCodeColor(info),
+		Description: info.Description,
+		Notes:       notes,
+		Link:        info.Link,
+	})
+}
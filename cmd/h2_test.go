@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFrameErrorCodesInfoStructure(t *testing.T) {
+	for _, codes := range [][]FrameErrorCodeInfo{http2ErrorCodesInfo, http3ErrorCodesInfo} {
+		seen := make(map[uint64]bool)
+		for _, info := range codes {
+			t.Run(info.Name, func(t *testing.T) {
+				if seen[info.Code] {
+					t.Errorf("Error code 0x%x is defined more than once", info.Code)
+				}
+				seen[info.Code] = true
+
+				if info.Description == "" || info.Section == "" {
+					t.Errorf("Error code %s missing Description or Section", info.Name)
+				}
+
+				if !strings.HasPrefix(info.Link, "https://www.rfc-editor.org/rfc/") {
+					t.Errorf("Error code %s has unexpected link %s", info.Name, info.Link)
+				}
+
+				if _, exists := frameErrorSpaceClasses[info.Space]; !exists {
+					t.Errorf("Error code %s has unknown space %q", info.Name, info.Space)
+				}
+			})
+		}
+	}
+
+	if len(http2ErrorCodesInfo) != 14 {
+		t.Errorf("Expected 14 HTTP/2 error codes (NO_ERROR to HTTP_1_1_REQUIRED), got %d", len(http2ErrorCodesInfo))
+	}
+}
+
+func TestFindFrameErrorCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		codes    []FrameErrorCodeInfo
+		arg      string
+		wantName string
+		wantOK   bool
+	}{
+		{"h2 decimal", http2ErrorCodesInfo, "7", "REFUSED_STREAM", true},
+		{"h2 hex", http2ErrorCodesInfo, "0xb", "ENHANCE_YOUR_CALM", true},
+		{"h2 name", http2ErrorCodesInfo, "http_1_1_required", "HTTP_1_1_REQUIRED", true},
+		{"h2 dashed name", http2ErrorCodesInfo, "refused-stream", "REFUSED_STREAM", true},
+		{"h3 without prefix", http3ErrorCodesInfo, "request_rejected", "H3_REQUEST_REJECTED", true},
+		{"h3 decimal", http3ErrorCodesInfo, "256", "H3_NO_ERROR", true},
+		{"qpack", http3ErrorCodesInfo, "0x202", "QPACK_DECODER_STREAM_ERROR", true},
+		{"h2 code in h3 space", http3ErrorCodesInfo, "0x7", "", false},
+		{"unknown name", http2ErrorCodesInfo, "NOPE", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, ok := findFrameErrorCode(tt.codes, tt.arg)
+			if ok != tt.wantOK {
+				t.Fatalf("findFrameErrorCode(%q) found = %v, expected %v", tt.arg, ok, tt.wantOK)
+			}
+			if ok && info.Name != tt.wantName {
+				t.Errorf("findFrameErrorCode(%q) = %s, expected %s", tt.arg, info.Name, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestLookupFrameErrorCode(t *testing.T) {
+	tests := []struct {
+		name         string
+		space        string
+		codes        []FrameErrorCodeInfo
+		arg          string
+		wantContains []string
+	}{
+		{
+			name:  "refused stream",
+			space: frameErrorSpaceH2,
+			codes: http2ErrorCodesInfo,
+			arg:   "REFUSED_STREAM",
+			wantContains: []string{
+				"HTTP/2 0x7 REFUSED_STREAM",
+				"RST_STREAM, GOAWAY",
+				"retried safely",
+				"rfc9113#section-8.7",
+			},
+		},
+		{
+			name:  "qpack",
+			space: frameErrorSpaceH3,
+			codes: http3ErrorCodesInfo,
+			arg:   "QPACK_DECOMPRESSION_FAILED",
+			wantContains: []string{
+				"QPACK 0x200 QPACK_DECOMPRESSION_FAILED",
+				"rfc9204#section-6",
+			},
+		},
+		{
+			name:  "grease",
+			space: frameErrorSpaceH3,
+			codes: http3ErrorCodesInfo,
+			arg:   "0x40",
+			wantContains: []string{
+				"HTTP/3 0x40 (reserved)",
+				"grease",
+			},
+		},
+		{
+			name:  "not found",
+			space: frameErrorSpaceH2,
+			codes: http2ErrorCodesInfo,
+			arg:   "0x40",
+			wantContains: []string{
+				"HTTP/2 error code 0x40 not found",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout, _ := captureOutput(func() {
+				lookupFrameErrorCode(tt.space, tt.codes, tt.arg)
+			})
+
+			for _, want := range tt.wantContains {
+				if !strings.Contains(stdout, want) {
+					t.Errorf("Expected '%s' in output, got: %s", want, stdout)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+// Color used for HTTP header names
+var headerNameColor = lipgloss.Color("#16a085")
+
+// headerCmd represents the header command
+var headerCmd = &cobra.Command{
+	Use:   "header [name]",
+	Short: "Look up standard HTTP headers",
+	Long: `Look up standard HTTP request and response headers: purpose, syntax,
+defining specification, MDN documentation and related status codes.
+
+Running 'httpcode header' without arguments launches the interactive fuzzy search.`,
+	Args: cobra.ArbitraryArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 {
+			runHeaderFzfSearch()
+			return
+		}
+		lookupHeader(args[0])
+	},
+}
+
+// headerListCmd represents the header list command
+var headerListCmd = &cobra.Command{
+	Use:       "list [request|response]",
+	Short:     "List HTTP headers",
+	Long:      `List all known HTTP headers or only those used in requests or responses.`,
+	ValidArgs: []string{"request", "response"},
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) > 0 {
+			listHeaders(args[0])
+		} else {
+			listHeaders("")
+		}
+	},
+}
+
+// headerSearchCmd represents the header search command
+var headerSearchCmd = &cobra.Command{
+	Use:   "search",
+	Short: "Interactive fuzzy search for HTTP headers",
+	Long:  `Use fuzzy search to interactively search for HTTP headers with detailed preview.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		runHeaderFzfSearch()
+	},
+}
+
+func init() {
+	headerCmd.AddCommand(headerListCmd)
+	headerCmd.AddCommand(headerSearchCmd)
+	rootCmd.AddCommand(headerCmd)
+}
+
+// lookupHeader looks up a specific HTTP header by name
+func lookupHeader(name string) {
+	if info, exists := findHeader(name); exists {
+		displayHeaderWithLipgloss(info)
+	} else {
+		displayErrorWithLipgloss(fmt.Sprintf("HTTP header %s not found", name))
+	}
+}
+
+// sortedHeaderNames returns the lower-case header names in alphabetical order
+func sortedHeaderNames() []string {
+	var names []string
+	for name := range httpHeadersInfo {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Header list group titles by usage
+var headerGroups = map[string]string{
+	headerUsageRequest:  "Request Headers",
+	headerUsageResponse: "Response Headers",
+	headerUsageBoth:     "Request and Response Headers",
+}
+
+func listHeaders(usage string) {
+	// Headers usable in both directions are listed for either kind
+	var groups []string
+	switch strings.ToLower(usage) {
+	case "":
+		displayListHeaderWithLipgloss("All HTTP Headers")
+		groups = []string{headerUsageRequest, headerUsageResponse, headerUsageBoth}
+	case "request":
+		displayListHeaderWithLipgloss("HTTP Request Headers")
+		groups = []string{headerUsageRequest, headerUsageBoth}
+	case "response":
+		displayListHeaderWithLipgloss("HTTP Response Headers")
+		groups = []string{headerUsageResponse, headerUsageBoth}
+	default:
+		displayErrorWithLipgloss("Invalid header kind. Use request or response.")
+		return
+	}
+
+	names := sortedHeaderNames()
+	for _, group := range groups {
+		displayHeaderGroupWithLipgloss(headerGroups[group])
+		for _, name := range names {
+			info := httpHeadersInfo[name]
+			if info.Usage == group {
+				displayHeaderListItemWithLipgloss(info)
+			}
+		}
+	}
+}
+
+func runHeaderFzfSearch() {
+	// Prepare data for fuzzy search
+	var items []string
+	var headerMap = make(map[string]string)
+
+	for _, name := range sortedHeaderNames() {
+		info := httpHeadersInfo[name]
+
+		item := fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s\t%s",
+			escapeString(info.Name),
+			escapeString(info.Purpose),
+			info.Usage,
+			escapeString(info.Syntax),
+			escapeString(info.Spec),
+			escapeString(formatRelatedCodes(info.RelatedCodes)),
+			escapeString(info.MDNLink))
+
+		items = append(items, item)
+		headerMap[item] = name
+	}
+
+	previewCmd := "echo -e '\\033[1;32mHTTP Header:\\033[0m {1}\\n" +
+		"\\033[1;32mUsage:\\033[0m       {3}\\n" +
+		"\\033[1;32mPurpose:\\033[0m\\n{2}\\n" +
+		"\\033[1;32mSyntax:\\033[0m\\n{4}\\n" +
+		"\\033[1;32mSpec:\\033[0m        {5}\\n" +
+		"\\033[1;32mRelated:\\033[0m     {6}\\n" +
+		"\\033[1;32mMDN Docs:\\033[0m\\n{7}'"
+
+	selection, ok := runFzfPicker(fzfPicker{
+		Items:   items,
+		Header:  "Header    Purpose          (Press ESC to exit, Enter to select)",
+		Label:   "httpcode - HTTP Header Viewer",
+		Preview: previewCmd,
+	})
+	if !ok {
+		return
+	}
+
+	if name, exists := headerMap[selection]; exists {
+		displayHeaderWithLipgloss(httpHeadersInfo[name])
+	} else {
+		fmt.Println(selection)
+	}
+}
+
+// displayHeaderWithLipgloss displays HTTP header information using Lipgloss styling
+func displayHeaderWithLipgloss(info HTTPHeaderInfo) {
+	header := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(headerNameColor).
+		Render(fmt.Sprintf("           %s", info.Name))
+	fmt.Println(header)
+
+	usage := lipgloss.NewStyle().
+		Foreground(headerNameColor).
+		Render(fmt.Sprintf("📋 Usage:       %s", info.Usage))
+	fmt.Println(usage)
+
+	fmt.Printf("📝 Purpose:     %s\n", info.Purpose)
+	fmt.Printf("🧾 Syntax:      %s\n", info.Syntax)
+	fmt.Printf("📜 Spec:        %s\n", info.Spec)
+
+	// Color each related status code with its category color
+	if len(info.RelatedCodes) > 0 {
+		fmt.Printf("🔢 Related:     %s\n", formatColoredCodes(info.RelatedCodes))
+	}
+
+	link := lipgloss.NewStyle().
+		Foreground(linkColor).
+		Render(fmt.Sprintf("🔗 Docs:        %s", info.MDNLink))
+	fmt.Println(link)
+
+	fmt.Println()
+}
+
+// displayHeaderGroupWithLipgloss displays a header group title in a list
+func displayHeaderGroupWithLipgloss(title string) {
+	group := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(headerNameColor).
+		Render(title)
+	fmt.Println(group)
+}
+
+// displayHeaderListItemWithLipgloss displays a single header in a list
+func displayHeaderListItemWithLipgloss(info HTTPHeaderInfo) {
+	item := lipgloss.NewStyle().
+		Foreground(headerNameColor).
+		Render(fmt.Sprintf("  %s", info.Name))
+	fmt.Println(item + ": " + info.Purpose)
+}
@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestHTTPHeadersInfoStructure(t *testing.T) {
+	for key, info := range httpHeadersInfo {
+		t.Run(fmt.Sprintf("header_%s_structure", key), func(t *testing.T) {
+			if strings.ToLower(info.Name) != key {
+				t.Errorf("Header key %q does not match name %q", key, info.Name)
+			}
+
+			if info.Purpose == "" || info.Syntax == "" || info.Spec == "" {
+				t.Errorf("Header %s missing Purpose, Syntax or Spec", info.Name)
+			}
+
+			if !strings.HasPrefix(info.Syntax, info.Name+":") {
+				t.Errorf("Header %s syntax should start with %q, got: %s", info.Name, info.Name+":", info.Syntax)
+			}
+
+			if !strings.HasPrefix(info.MDNLink, "https://developer.mozilla.org/") {
+				t.Errorf("Header %s MDN link should start with https://developer.mozilla.org/, got: %s", info.Name, info.MDNLink)
+			}
+
+			switch info.Usage {
+			case headerUsageRequest, headerUsageResponse, headerUsageBoth:
+			default:
+				t.Errorf("Header %s has invalid usage %q", info.Name, info.Usage)
+			}
+
+			for _, code := range info.RelatedCodes {
+				if _, exists := httpCodesInfo[code]; !exists {
+					t.Errorf("Header %s references unknown status code %d", info.Name, code)
+				}
+			}
+		})
+	}
+}
+
+func TestLookupHeader(t *testing.T) {
+	tests := []struct {
+		name         string
+		header       string
+		wantContains []string
+	}{
+		{
+			name:   "exact name",
+			header: "Retry-After",
+			wantContains: []string{
+				"Retry-After",
+				"Usage:",
+				"Response",
+				"RFC 9110",
+				"429 Too Many Requests",
+				"503 Service Unavailable",
+				"Docs:",
+			},
+		},
+		{
+			name:   "case insensitive",
+			header: "content-type",
+			wantContains: []string{
+				"Content-Type",
+				"415 Unsupported Media Type",
+			},
+		},
+		{
+			name:   "unknown header",
+			header: "X-Not-A-Header",
+			wantContains: []string{
+				"HTTP header X-Not-A-Header not found",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout, _ := captureOutput(func() {
+				lookupHeader(tt.header)
+			})
+
+			for _, want := range tt.wantContains {
+				if !strings.Contains(stdout, want) {
+					t.Errorf("Expected '%s' in output, got: %s", want, stdout)
+				}
+			}
+		})
+	}
+}
+
+func TestListHeaders(t *testing.T) {
+	tests := []struct {
+		name            string
+		usage           string
+		wantContains    []string
+		wantNotContains []string
+	}{
+		{
+			name:  "all headers",
+			usage: "",
+			wantContains: []string{
+				"All HTTP Headers",
+				"Request Headers",
+				"Response Headers",
+				"Authorization:",
+				"Location:",
+			},
+		},
+		{
+			name:  "request headers",
+			usage: "request",
+			wantContains: []string{
+				"HTTP Request Headers",
+				"Authorization:",
+				"Content-Type:",
+			},
+			wantNotContains: []string{
+				"Location:",
+			},
+		},
+		{
+			name:  "invalid kind",
+			usage: "both",
+			wantContains: []string{
+				"Invalid header kind",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout, _ := captureOutput(func() {
+				listHeaders(tt.usage)
+			})
+
+			for _, want := range tt.wantContains {
+				if !strings.Contains(stdout, want) {
+					t.Errorf("Expected '%s' in output, got: %s", want, stdout)
+				}
+			}
+
+			for _, unwanted := range tt.wantNotContains {
+				if strings.Contains(stdout, unwanted) {
+					t.Errorf("Did not expect '%s' in output, got: %s", unwanted, stdout)
+				}
+			}
+		})
+	}
+}
+
+func TestFormatRelatedCodes(t *testing.T) {
+	tests := []struct {
+		name     string
+		codes    []int
+		expected string
+	}{
+		{
+			name:     "no codes",
+			codes:    nil,
+			expected: "-",
+		},
+		{
+			name:     "known codes",
+			codes:    []int{304, 412},
+			expected: "304 Not Modified, 412 Precondition Failed",
+		},
+		{
+			name:     "unknown code",
+			codes:    []int{499},
+			expected: "499",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := formatRelatedCodes(tt.codes)
+			if result != tt.expected {
+				t.Errorf("formatRelatedCodes(%v) = %q, want %q", tt.codes, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,465 @@
+package cmd
+
+import "strings"
+
+// Where an HTTP header field is used
+const (
+	headerUsageRequest  = "Request"
+	headerUsageResponse = "Response"
+	headerUsageBoth     = "Request/Response"
+)
+
+// HTTPHeaderInfo contains reference information about an HTTP header field
+type HTTPHeaderInfo struct {
+	Name         string
+	Usage        string
+	Purpose      string
+	Syntax       string
+	Spec         string
+	MDNLink      string
+	RelatedCodes []int
+}
+
+// Standard HTTP header fields, keyed by lower-case field name
+var httpHeadersInfo = map[string]HTTPHeaderInfo{
+	"accept": {
+		Name:         "Accept",
+		Usage:        headerUsageRequest,
+		Purpose:      "Indicates which media types the client is able to understand, in order of preference.",
+		Syntax:       "Accept: <media-type>/<subtype>[;q=<weight>], ...",
+		Spec:         "RFC 9110, Section 12.5.1",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Accept",
+		RelatedCodes: []int{406},
+	},
+	"accept-encoding": {
+		Name:         "Accept-Encoding",
+		Usage:        headerUsageRequest,
+		Purpose:      "Indicates the content encodings (usually compression algorithms) the client is able to understand.",
+		Syntax:       "Accept-Encoding: gzip, deflate, br, zstd, identity, *[;q=<weight>]",
+		Spec:         "RFC 9110, Section 12.5.3",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Accept-Encoding",
+		RelatedCodes: []int{406, 415},
+	},
+	"accept-language": {
+		Name:         "Accept-Language",
+		Usage:        headerUsageRequest,
+		Purpose:      "Indicates the natural languages and locales that the client prefers.",
+		Syntax:       "Accept-Language: <language>[;q=<weight>], ...",
+		Spec:         "RFC 9110, Section 12.5.4",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Accept-Language",
+		RelatedCodes: []int{406},
+	},
+	"accept-ranges": {
+		Name:         "Accept-Ranges",
+		Usage:        headerUsageResponse,
+		Purpose:      "Advertises that the server supports range requests for the target resource, and the unit that may be used.",
+		Syntax:       "Accept-Ranges: bytes | none",
+		Spec:         "RFC 9110, Section 14.3",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Accept-Ranges",
+		RelatedCodes: []int{206, 416},
+	},
+	"access-control-allow-origin": {
+		Name:         "Access-Control-Allow-Origin",
+		Usage:        headerUsageResponse,
+		Purpose:      "Indicates whether the response can be shared with requesting code from the given origin (CORS).",
+		Syntax:       "Access-Control-Allow-Origin: * | <origin> | null",
+		Spec:         "WHATWG Fetch Standard, CORS protocol",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Access-Control-Allow-Origin",
+		RelatedCodes: []int{200, 204, 403},
+	},
+	"age": {
+		Name:         "Age",
+		Usage:        headerUsageResponse,
+		Purpose:      "Contains the time in seconds the object was in a proxy cache.",
+		Syntax:       "Age: <delta-seconds>",
+		Spec:         "RFC 9111, Section 5.1",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Age",
+		RelatedCodes: []int{200, 304},
+	},
+	"allow": {
+		Name:         "Allow",
+		Usage:        headerUsageResponse,
+		Purpose:      "Lists the set of methods supported by the target resource.",
+		Syntax:       "Allow: <http-methods>",
+		Spec:         "RFC 9110, Section 10.2.1",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Allow",
+		RelatedCodes: []int{405},
+	},
+	"authorization": {
+		Name:         "Authorization",
+		Usage:        headerUsageRequest,
+		Purpose:      "Contains the credentials to authenticate a user agent with a server.",
+		Syntax:       "Authorization: <auth-scheme> <credentials>",
+		Spec:         "RFC 9110, Section 11.6.2",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Authorization",
+		RelatedCodes: []int{401, 403},
+	},
+	"cache-control": {
+		Name:         "Cache-Control",
+		Usage:        headerUsageBoth,
+		Purpose:      "Holds directives that control caching in browsers and shared caches such as proxies and CDNs.",
+		Syntax:       "Cache-Control: no-cache | no-store | max-age=<seconds> | private | public | ...",
+		Spec:         "RFC 9111, Section 5.2",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Cache-Control",
+		RelatedCodes: []int{200, 304},
+	},
+	"connection": {
+		Name:         "Connection",
+		Usage:        headerUsageBoth,
+		Purpose:      "Controls whether the network connection stays open after the current transaction finishes, and lists hop-by-hop fields.",
+		Syntax:       "Connection: keep-alive | close | <field-name>",
+		Spec:         "RFC 9110, Section 7.6.1",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Connection",
+		RelatedCodes: []int{101},
+	},
+	"content-encoding": {
+		Name:         "Content-Encoding",
+		Usage:        headerUsageBoth,
+		Purpose:      "Lists the encodings that have been applied to the representation, and in which order.",
+		Syntax:       "Content-Encoding: gzip | compress | deflate | br | zstd",
+		Spec:         "RFC 9110, Section 8.4",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Content-Encoding",
+		RelatedCodes: []int{415},
+	},
+	"content-language": {
+		Name:    "Content-Language",
+		Usage:   headerUsageBoth,
+		Purpose: "Describes the natural language(s) of the intended audience for the representation.",
+		Syntax:  "Content-Language: <language-tag>, ...",
+		Spec:    "RFC 9110, Section 8.5",
+		MDNLink: "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Content-Language",
+	},
+	"content-length": {
+		Name:         "Content-Length",
+		Usage:        headerUsageBoth,
+		Purpose:      "Indicates the size of the message body, in bytes, sent to the recipient.",
+		Syntax:       "Content-Length: <length>",
+		Spec:         "RFC 9110, Section 8.6",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Content-Length",
+		RelatedCodes: []int{411, 413},
+	},
+	"content-location": {
+		Name:         "Content-Location",
+		Usage:        headerUsageResponse,
+		Purpose:      "Indicates an alternate location for the returned data.",
+		Syntax:       "Content-Location: <url>",
+		Spec:         "RFC 9110, Section 8.7",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Content-Location",
+		RelatedCodes: []int{200, 201},
+	},
+	"content-range": {
+		Name:         "Content-Range",
+		Usage:        headerUsageResponse,
+		Purpose:      "Indicates where in a full body message a partial message belongs.",
+		Syntax:       "Content-Range: <unit> <range-start>-<range-end>/<size>",
+		Spec:         "RFC 9110, Section 14.4",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Content-Range",
+		RelatedCodes: []int{206, 416},
+	},
+	"content-security-policy": {
+		Name:    "Content-Security-Policy",
+		Usage:   headerUsageResponse,
+		Purpose: "Controls the resources the user agent is allowed to load for a given page, helping to guard against cross-site scripting.",
+		Syntax:  "Content-Security-Policy: <policy-directive>; <policy-directive>",
+		Spec:    "W3C Content Security Policy Level 3",
+		MDNLink: "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Content-Security-Policy",
+	},
+	"content-type": {
+		Name:         "Content-Type",
+		Usage:        headerUsageBoth,
+		Purpose:      "Indicates the original media type of the resource before any content encoding is applied.",
+		Syntax:       "Content-Type: <media-type>[; charset=<charset>][; boundary=<boundary>]",
+		Spec:         "RFC 9110, Section 8.3",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Content-Type",
+		RelatedCodes: []int{415},
+	},
+	"cookie": {
+		Name:    "Cookie",
+		Usage:   headerUsageRequest,
+		Purpose: "Contains stored HTTP cookies previously sent by the server with the Set-Cookie header.",
+		Syntax:  "Cookie: <name>=<value>; <name>=<value>",
+		Spec:    "RFC 6265, Section 5.4",
+		MDNLink: "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Cookie",
+	},
+	"date": {
+		Name:    "Date",
+		Usage:   headerUsageBoth,
+		Purpose: "Contains the date and time at which the message originated.",
+		Syntax:  "Date: <day-name>, <day> <month> <year> <hour>:<minute>:<second> GMT",
+		Spec:    "RFC 9110, Section 6.6.1",
+		MDNLink: "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Date",
+	},
+	"etag": {
+		Name:         "ETag",
+		Usage:        headerUsageResponse,
+		Purpose:      "An identifier for a specific version of a resource, used for cache validation and conditional requests.",
+		Syntax:       "ETag: [W/]\"<etag-value>\"",
+		Spec:         "RFC 9110, Section 8.8.3",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/ETag",
+		RelatedCodes: []int{304, 412},
+	},
+	"expect": {
+		Name:         "Expect",
+		Usage:        headerUsageRequest,
+		Purpose:      "Indicates expectations that need to be met by the server to handle the request successfully.",
+		Syntax:       "Expect: 100-continue",
+		Spec:         "RFC 9110, Section 10.1.1",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Expect",
+		RelatedCodes: []int{100, 417},
+	},
+	"expires": {
+		Name:    "Expires",
+		Usage:   headerUsageResponse,
+		Purpose: "Contains the date/time after which the response is considered stale.",
+		Syntax:  "Expires: <http-date>",
+		Spec:    "RFC 9111, Section 5.3",
+		MDNLink: "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Expires",
+	},
+	"forwarded": {
+		Name:    "Forwarded",
+		Usage:   headerUsageRequest,
+		Purpose: "Contains information from the client-facing side of proxy servers that is altered or lost when a proxy is involved in the path of the request.",
+		Syntax:  "Forwarded: by=<identifier>;for=<identifier>;host=<host>;proto=<http|https>",
+		Spec:    "RFC 7239, Section 4",
+		MDNLink: "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Forwarded",
+	},
+	"host": {
+		Name:         "Host",
+		Usage:        headerUsageRequest,
+		Purpose:      "Specifies the host and port number of the server to which the request is being sent.",
+		Syntax:       "Host: <host>[:<port>]",
+		Spec:         "RFC 9110, Section 7.2",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Host",
+		RelatedCodes: []int{400, 421},
+	},
+	"if-match": {
+		Name:         "If-Match",
+		Usage:        headerUsageRequest,
+		Purpose:      "Makes the request conditional: the method is only applied if the stored resource matches one of the given ETags.",
+		Syntax:       "If-Match: <etag_value>, ... | *",
+		Spec:         "RFC 9110, Section 13.1.1",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/If-Match",
+		RelatedCodes: []int{412, 428},
+	},
+	"if-modified-since": {
+		Name:         "If-Modified-Since",
+		Usage:        headerUsageRequest,
+		Purpose:      "Makes the request conditional: the server sends the resource only if it has been modified after the given date.",
+		Syntax:       "If-Modified-Since: <http-date>",
+		Spec:         "RFC 9110, Section 13.1.3",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/If-Modified-Since",
+		RelatedCodes: []int{304},
+	},
+	"if-none-match": {
+		Name:         "If-None-Match",
+		Usage:        headerUsageRequest,
+		Purpose:      "Makes the request conditional: the method is only applied if the stored resource does not match any of the given ETags.",
+		Syntax:       "If-None-Match: <etag_value>, ... | *",
+		Spec:         "RFC 9110, Section 13.1.2",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/If-None-Match",
+		RelatedCodes: []int{304, 412},
+	},
+	"if-range": {
+		Name:         "If-Range",
+		Usage:        headerUsageRequest,
+		Purpose:      "Makes a range request conditional: the range is only sent if the validator matches, otherwise the full resource is returned.",
+		Syntax:       "If-Range: <http-date> | <etag>",
+		Spec:         "RFC 9110, Section 13.1.5",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/If-Range",
+		RelatedCodes: []int{200, 206},
+	},
+	"if-unmodified-since": {
+		Name:         "If-Unmodified-Since",
+		Usage:        headerUsageRequest,
+		Purpose:      "Makes the request conditional: the method is only applied if the resource has not been modified after the given date.",
+		Syntax:       "If-Unmodified-Since: <http-date>",
+		Spec:         "RFC 9110, Section 13.1.4",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/If-Unmodified-Since",
+		RelatedCodes: []int{412},
+	},
+	"last-modified": {
+		Name:         "Last-Modified",
+		Usage:        headerUsageResponse,
+		Purpose:      "Contains the date and time at which the origin server believes the resource was last modified.",
+		Syntax:       "Last-Modified: <http-date>",
+		Spec:         "RFC 9110, Section 8.8.2",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Last-Modified",
+		RelatedCodes: []int{304},
+	},
+	"link": {
+		Name:         "Link",
+		Usage:        headerUsageResponse,
+		Purpose:      "Provides a means for serializing one or more links, such as preload hints or pagination relations.",
+		Syntax:       "Link: <uri-reference>; rel=\"<relation>\"",
+		Spec:         "RFC 8288, Section 3",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Link",
+		RelatedCodes: []int{103},
+	},
+	"location": {
+		Name:         "Location",
+		Usage:        headerUsageResponse,
+		Purpose:      "Indicates the URL to redirect a page to, or the URL of a newly created resource.",
+		Syntax:       "Location: <url>",
+		Spec:         "RFC 9110, Section 10.2.2",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Location",
+		RelatedCodes: []int{201, 301, 302, 303, 307, 308},
+	},
+	"max-forwards": {
+		Name:    "Max-Forwards",
+		Usage:   headerUsageRequest,
+		Purpose: "Limits the number of times a TRACE or OPTIONS request may be forwarded by proxies.",
+		Syntax:  "Max-Forwards: <integer>",
+		Spec:    "RFC 9110, Section 7.6.2",
+		MDNLink: "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Max-Forwards",
+	},
+	"origin": {
+		Name:         "Origin",
+		Usage:        headerUsageRequest,
+		Purpose:      "Indicates the origin (scheme, host and port) that caused the request, used by CORS and CSRF protections.",
+		Syntax:       "Origin: null | <scheme>://<hostname>[:<port>]",
+		Spec:         "RFC 6454, Section 7",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Origin",
+		RelatedCodes: []int{403},
+	},
+	"proxy-authenticate": {
+		Name:         "Proxy-Authenticate",
+		Usage:        headerUsageResponse,
+		Purpose:      "Defines the authentication method that should be used to access a resource behind a proxy server.",
+		Syntax:       "Proxy-Authenticate: <auth-scheme> realm=<realm>",
+		Spec:         "RFC 9110, Section 11.7.1",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Proxy-Authenticate",
+		RelatedCodes: []int{407},
+	},
+	"proxy-authorization": {
+		Name:         "Proxy-Authorization",
+		Usage:        headerUsageRequest,
+		Purpose:      "Contains the credentials to authenticate a user agent with a proxy server.",
+		Syntax:       "Proxy-Authorization: <auth-scheme> <credentials>",
+		Spec:         "RFC 9110, Section 11.7.2",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Proxy-Authorization",
+		RelatedCodes: []int{407},
+	},
+	"range": {
+		Name:         "Range",
+		Usage:        headerUsageRequest,
+		Purpose:      "Indicates the part of a document that the server should return.",
+		Syntax:       "Range: <unit>=<range-start>-[<range-end>], ...",
+		Spec:         "RFC 9110, Section 14.2",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Range",
+		RelatedCodes: []int{206, 416},
+	},
+	"referer": {
+		Name:    "Referer",
+		Usage:   headerUsageRequest,
+		Purpose: "Contains the address of the page from which the request was made.",
+		Syntax:  "Referer: <url>",
+		Spec:    "RFC 9110, Section 10.1.3",
+		MDNLink: "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Referer",
+	},
+	"retry-after": {
+		Name:         "Retry-After",
+		Usage:        headerUsageResponse,
+		Purpose:      "Indicates how long the user agent should wait before making a follow-up request.",
+		Syntax:       "Retry-After: <http-date> | <delay-seconds>",
+		Spec:         "RFC 9110, Section 10.2.3",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Retry-After",
+		RelatedCodes: []int{413, 429, 503},
+	},
+	"server": {
+		Name:    "Server",
+		Usage:   headerUsageResponse,
+		Purpose: "Contains information about the software used by the origin server to handle the request.",
+		Syntax:  "Server: <product>",
+		Spec:    "RFC 9110, Section 10.2.4",
+		MDNLink: "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Server",
+	},
+	"set-cookie": {
+		Name:    "Set-Cookie",
+		Usage:   headerUsageResponse,
+		Purpose: "Sends a cookie from the server to the user agent, so the user agent can send it back later.",
+		Syntax:  "Set-Cookie: <name>=<value>[; Expires=<date>][; Max-Age=<n>][; Domain=<d>][; Path=<p>][; Secure][; HttpOnly][; SameSite=<v>]",
+		Spec:    "RFC 6265, Section 4.1",
+		MDNLink: "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Set-Cookie",
+	},
+	"strict-transport-security": {
+		Name:    "Strict-Transport-Security",
+		Usage:   headerUsageResponse,
+		Purpose: "Informs browsers that the host should only be accessed using HTTPS, and that future HTTP attempts should be upgraded.",
+		Syntax:  "Strict-Transport-Security: max-age=<expire-time>[; includeSubDomains][; preload]",
+		Spec:    "RFC 6797, Section 6.1",
+		MDNLink: "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Strict-Transport-Security",
+	},
+	"te": {
+		Name:    "TE",
+		Usage:   headerUsageRequest,
+		Purpose: "Specifies the transfer encodings the user agent is willing to accept, and whether it accepts trailer fields.",
+		Syntax:  "TE: trailers | compress | deflate | gzip[;q=<weight>]",
+		Spec:    "RFC 9110, Section 10.1.4",
+		MDNLink: "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/TE",
+	},
+	"trailer": {
+		Name:    "Trailer",
+		Usage:   headerUsageBoth,
+		Purpose: "Announces which header fields will be present in the trailer section of a chunked message.",
+		Syntax:  "Trailer: <header-names>",
+		Spec:    "RFC 9110, Section 6.6.2",
+		MDNLink: "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Trailer",
+	},
+	"transfer-encoding": {
+		Name:         "Transfer-Encoding",
+		Usage:        headerUsageBoth,
+		Purpose:      "Specifies the form of encoding used to safely transfer the message body to the next hop.",
+		Syntax:       "Transfer-Encoding: chunked | compress | deflate | gzip",
+		Spec:         "RFC 9112, Section 6.1",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Transfer-Encoding",
+		RelatedCodes: []int{411, 501},
+	},
+	"upgrade": {
+		Name:         "Upgrade",
+		Usage:        headerUsageBoth,
+		Purpose:      "Asks the server to switch to a different protocol on the same connection, or lists protocols the server requires.",
+		Syntax:       "Upgrade: <protocol>[/<version>], ...",
+		Spec:         "RFC 9110, Section 7.8",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Upgrade",
+		RelatedCodes: []int{101, 426},
+	},
+	"user-agent": {
+		Name:    "User-Agent",
+		Usage:   headerUsageRequest,
+		Purpose: "Contains a characteristic string that identifies the application, operating system and version of the requesting user agent.",
+		Syntax:  "User-Agent: <product>/<product-version> <comment>",
+		Spec:    "RFC 9110, Section 10.1.5",
+		MDNLink: "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/User-Agent",
+	},
+	"vary": {
+		Name:    "Vary",
+		Usage:   headerUsageResponse,
+		Purpose: "Describes the request headers that influenced the content of the response, so caches can key on them.",
+		Syntax:  "Vary: * | <header-name>, ...",
+		Spec:    "RFC 9110, Section 12.5.5",
+		MDNLink: "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Vary",
+	},
+	"via": {
+		Name:    "Via",
+		Usage:   headerUsageBoth,
+		Purpose: "Added by proxies, both forward and reverse, to track message forwards and avoid request loops.",
+		Syntax:  "Via: [<protocol-name>/]<protocol-version> <host>[:<port>], ...",
+		Spec:    "RFC 9110, Section 7.6.3",
+		MDNLink: "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Via",
+	},
+	"www-authenticate": {
+		Name:         "WWW-Authenticate",
+		Usage:        headerUsageResponse,
+		Purpose:      "Defines the HTTP authentication methods (challenges) that might be used to gain access to a resource.",
+		Syntax:       "WWW-Authenticate: <auth-scheme> realm=<realm>[, charset=\"UTF-8\"]",
+		Spec:         "RFC 9110, Section 11.6.1",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/WWW-Authenticate",
+		RelatedCodes: []int{401},
+	},
+}
+
+// findHeader looks up a header field by name, ignoring case
+func findHeader(name string) (HTTPHeaderInfo, bool) {
+	info, exists := httpHeadersInfo[strings.ToLower(strings.TrimSpace(name))]
+	return info, exists
+}
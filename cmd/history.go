@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+// historyCmd represents the history command
+var historyCmd = &cobra.Command{
+	Use:   "history [code|name]",
+	Short: "Show how a status code's name changed across specs",
+	Long: `Show the lineage of a status code's name and standing across RFC 2616,
+RFC 7231 and RFC 9110, and the RFCs that introduced codes outside the core
+specs: renames such as 413 "Payload Too Large" to "Content Too Large",
+deprecations such as 305 Use Proxy, and unused codes such as 306.
+
+The argument can be a status code or any current or historical name.
+Running 'httpcode history' without arguments lists the codes whose name or
+standing changed.`,
+	Args: cobra.ArbitraryArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 {
+			listChangedStatusCodes()
+			return
+		}
+		lookupHistory(strings.Join(args, " "))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(historyCmd)
+}
+
+// lookupHistory shows the lineage of a status code given by number or name
+func lookupHistory(arg string) {
+	code, err := strconv.Atoi(arg)
+	if err != nil {
+		var exists bool
+		if code, exists = resolveStatusName(arg); !exists {
+			displayErrorWithLipgloss(fmt.Sprintf("Status code name %s not found", arg))
+			return
+		}
+	}
+
+	revisions, exists := statusCodeLineage[code]
+	if !exists {
+		displayErrorWithLipgloss(fmt.Sprintf("HTTP status code %d not found", code))
+		return
+	}
+	displayHistoryWithLipgloss(code, revisions)
+}
+
+// currentStatusName returns the registered name of a status code, or its name
+// in the latest spec for codes that are no longer registered
+func currentStatusName(code int) string {
+	if info, exists := httpCodesInfo[code]; exists {
+		return info.Description
+	}
+	revisions := statusCodeLineage[code]
+	return revisions[len(revisions)-1].Name
+}
+
+// hasChangedHistory reports whether a status code was renamed, deprecated,
+// removed or left unused
+func hasChangedHistory(code int) bool {
+	for _, revision := range statusCodeLineage[code] {
+		if revision.Status != "" {
+			return true
+		}
+	}
+	return len(formerStatusNames(code)) > 0
+}
+
+func listChangedStatusCodes() {
+	displayListHeaderWithLipgloss("Renamed and Retired Status Codes")
+	for _, code := range historicalStatusCodes() {
+		if !hasChangedHistory(code) {
+			continue
+		}
+		// Collapse consecutive specs that kept the same name and standing
+		var names []string
+		previous := ""
+		for _, revision := range statusCodeLineage[code] {
+			name := formatRevisionName(revision)
+			if name != previous {
+				names = append(names, fmt.Sprintf("%s (%s)", name, revision.Spec))
+			}
+			previous = name
+		}
+
+		label := lipgloss.NewStyle().
+			Foreground(getStatusCodeColor(code)).
+			Render(fmt.Sprintf("  %d", code))
+		fmt.Printf("%s %s\n", label, strings.Join(names, " → "))
+	}
+}
+
+// formatRevisionName formats a revision's name with its status, if any
+func formatRevisionName(revision specRevision) string {
+	if revision.Status == "" || revision.Status == revisionUnused {
+		return revision.Name
+	}
+	return fmt.Sprintf("%s [%s]", revision.Name, revision.Status)
+}
+
+func displayHistoryWithLipgloss(code int, revisions []specRevision) {
+	color := getStatusCodeColor(code)
+	header := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(color).
+		Render(fmt.Sprintf("           HTTP %d %s", code, currentStatusName(code)))
+	fmt.Println(header)
+
+	previous := ""
+	for _, revision := range revisions {
+		line := fmt.Sprintf("📜 %-13s%s", revision.Spec+":", revision.Name)
+		switch {
+		case revision.Status != "":
+			line += " [" + revision.Status + "]"
+		case previous != "" && normalizeStatusName(previous) != normalizeStatusName(revision.Name):
+			line += " [renamed]"
+		}
+		if revision.Note != "" {
+			line += ", " + revision.Note
+		}
+		previous = revision.Name
+
+		style := lipgloss.NewStyle()
+		if revision.Status != "" {
+			style = style.Foreground(redirectionColor)
+		}
+		fmt.Println(style.Render(line))
+	}
+	fmt.Println()
+}
+
+// displayFormerNameNote points out that a looked up name is not the current
+// name of its status code
+func displayFormerNameNote(name string, code int) {
+	key := normalizeStatusName(name)
+	if key == normalizeStatusName(httpCodesInfo[code].Description) {
+		return
+	}
+
+	spec := ""
+	for _, revision := range statusCodeLineage[code] {
+		if normalizeStatusName(revision.Name) == key {
+			spec = revision.Spec
+			name = revision.Name
+		}
+	}
+	message := lipgloss.NewStyle().
+		Foreground(redirectionColor).
+		Render(fmt.Sprintf("⚠️  Renamed:     %q is the %s name of %d, now %q", name, spec, code, httpCodesInfo[code].Description))
+	fmt.Println(message)
+}
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestStatusCodeLineage(t *testing.T) {
+	for code := range httpCodesInfo {
+		t.Run(fmt.Sprint(code), func(t *testing.T) {
+			revisions, exists := statusCodeLineage[code]
+			if !exists || len(revisions) == 0 {
+				t.Fatalf("Status code %d has no lineage", code)
+			}
+
+			for _, revision := range revisions {
+				if !strings.HasPrefix(revision.Spec, "RFC ") || revision.Name == "" {
+					t.Errorf("Status code %d has an incomplete revision %+v", code, revision)
+				}
+			}
+
+			// The current name resolves back to the status code
+			if resolved, exists := resolveStatusName(httpCodesInfo[code].Description); !exists || resolved != code {
+				t.Errorf("Current name of %d resolves to %d", code, resolved)
+			}
+		})
+	}
+}
+
+func TestResolveStatusName(t *testing.T) {
+	tests := []struct {
+		name       string
+		wantCode   int
+		wantExists bool
+	}{
+		{"Payload Too Large", 413, true},
+		{"request entity too large", 413, true},
+		{"Content Too Large", 413, true},
+		{"unprocessable-entity", 422, true},
+		{"Request-URI Too Long", 414, true},
+		{"Requested Range Not Satisfiable", 416, true},
+		{"Moved Temporarily", 302, true},
+		{"(Unused)", 0, false},
+		{"", 0, false},
+		{"Totally Fine", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, exists := resolveStatusName(tt.name)
+			if exists != tt.wantExists || (exists && code != tt.wantCode) {
+				t.Errorf("resolveStatusName(%q) = %d, %v; want %d, %v", tt.name, code, exists, tt.wantCode, tt.wantExists)
+			}
+		})
+	}
+}
+
+func TestFormerStatusNames(t *testing.T) {
+	if got := strings.Join(formerStatusNames(413), ", "); got != "Request Entity Too Large, Payload Too Large" {
+		t.Errorf("Unexpected former names of 413: %s", got)
+	}
+	if got := formerStatusNames(404); len(got) != 0 {
+		t.Errorf("Expected no former names of 404, got %v", got)
+	}
+}
+
+func TestLookupHistory(t *testing.T) {
+	tests := []struct {
+		name         string
+		arg          string
+		wantContains []string
+	}{
+		{
+			name: "renamed code",
+			arg:  "413",
+			wantContains: []string{
+				"HTTP 413 Content Too Large",
+				"RFC 2616:    Request Entity Too Large",
+				"RFC 7231:    Payload Too Large [renamed]",
+				"RFC 9110:    Content Too Large [renamed]",
+			},
+		},
+		{
+			name: "historical name",
+			arg:  "Unprocessable Entity",
+			wantContains: []string{
+				"HTTP 422 Unprocessable Content",
+				"RFC 4918:    Unprocessable Entity",
+			},
+		},
+		{
+			name: "deprecated code",
+			arg:  "305",
+			wantContains: []string{
+				"Use Proxy [deprecated]",
+			},
+		},
+		{
+			name: "unused code without registry entry",
+			arg:  "306",
+			wantContains: []string{
+				"HTTP 306 (Unused)",
+				"[unused]",
+			},
+		},
+		{
+			name:         "unknown code",
+			arg:          "999",
+			wantContains: []string{"HTTP status code 999 not found"},
+		},
+		{
+			name:         "unknown name",
+			arg:          "Totally Fine",
+			wantContains: []string{"Status code name Totally Fine not found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout, _ := captureOutput(func() {
+				lookupHistory(tt.arg)
+			})
+
+			for _, want := range tt.wantContains {
+				if !strings.Contains(stdout, want) {
+					t.Errorf("Expected '%s' in output, got: %s", want, stdout)
+				}
+			}
+		})
+	}
+}
+
+func TestLookupByHistoricalName(t *testing.T) {
+	stdout, _ := captureOutput(func() {
+		rootCmd.SetArgs([]string{"Payload", "Too", "Large"})
+		rootCmd.Execute()
+	})
+
+	for _, want := range []string{"HTTP 413 Content Too Large", `"Payload Too Large" is the RFC 7231 name of 413`} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("Expected '%s' in output, got: %s", want, stdout)
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Magic numbers of the compressed log formats
+var (
+	gzipMagic  = []byte{0x1f, 0x8b}
+	zstdMagic  = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	bzip2Magic = []byte("BZh")
+)
+
+// Extensions of compressed logs, removed before reading the rotation suffix
+var compressedLogExtensions = []string{".gz", ".zst", ".bz2"}
+
+// Rotation suffixes of logrotate: access.log-20261019 (dateext) or access.log.3
+var (
+	datedRotationPattern    = regexp.MustCompile(`^(.*?)[.-](\d{4}-?\d{2}-?\d{2}(?:-?\d+)?)$`)
+	numberedRotationPattern = regexp.MustCompile(`^(.*)\.(\d+)$`)
+)
+
+var (
+	// Number of log files read concurrently, set with --jobs
+	logJobs int
+	// Whether the rotated files of each log are read too, set with --rotated
+	logRotated bool
+)
+
+// compressedLogReader closes a decompressor and the file under it
+type compressedLogReader struct {
+	io.Reader
+	closers []func() error
+}
+
+func (r *compressedLogReader) Close() error {
+	var first error
+	for _, close := range r.closers {
+		if err := close(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// decompressLog returns a reader of the decompressed log, detecting gzip,
+// zstd and bzip2 by their magic number rather than the file name
+func decompressLog(r io.Reader) (io.ReadCloser, error) {
+	buffered := bufio.NewReaderSize(r, 64*1024)
+	header, _ := buffered.Peek(4)
+
+	switch {
+	case bytes.HasPrefix(header, gzipMagic):
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			return nil, err
+		}
+		return &compressedLogReader{Reader: gz, closers: []func() error{gz.Close}}, nil
+	case bytes.HasPrefix(header, zstdMagic):
+		zr, err := zstd.NewReader(buffered, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		return &compressedLogReader{Reader: zr, closers: []func() error{func() error { zr.Close(); return nil }}}, nil
+	case bytes.HasPrefix(header, bzip2Magic):
+		return io.NopCloser(bzip2.NewReader(buffered)), nil
+	default:
+		return io.NopCloser(buffered), nil
+	}
+}
+
+// openLogFile opens a log file, or stdin for "-", decompressing it
+func openLogFile(path string, stdin io.Reader) (io.ReadCloser, error) {
+	if path == "-" {
+		return decompressLog(stdin)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	r, err := decompressLog(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &compressedLogReader{Reader: r, closers: []func() error{r.Close, file.Close}}, nil
+}
+
+// rotationKey orders a rotated log file within its set: numbered files from
+// the oldest (highest number), then dated files by date, then the live file
+type rotationKey struct {
+	Base   string
+	Kind   int
+	Number int
+	Date   string
+}
+
+const (
+	rotationNumbered = iota
+	rotationDated
+	rotationLive
+)
+
+// parseRotationKey reads the rotation suffix of a log file name
+func parseRotationKey(path string) rotationKey {
+	name := path
+	for _, ext := range compressedLogExtensions {
+		name = strings.TrimSuffix(name, ext)
+	}
+	if match := datedRotationPattern.FindStringSubmatch(name); match != nil {
+		return rotationKey{Base: match[1], Kind: rotationDated, Date: strings.ReplaceAll(match[2], "-", "")}
+	}
+	if match := numberedRotationPattern.FindStringSubmatch(name); match != nil {
+		number, _ := strconv.Atoi(match[2])
+		return rotationKey{Base: match[1], Kind: rotationNumbered, Number: number}
+	}
+	return rotationKey{Base: name, Kind: rotationLive}
+}
+
+// sortLogFilesChronologically orders rotated sets from their oldest file to
+// the live one, keeping different logs in name order
+func sortLogFilesChronologically(paths []string) {
+	sort.SliceStable(paths, func(i, j int) bool {
+		a, b := parseRotationKey(paths[i]), parseRotationKey(paths[j])
+		switch {
+		case a.Base != b.Base:
+			return a.Base < b.Base
+		case a.Kind != b.Kind:
+			return a.Kind < b.Kind
+		case a.Kind == rotationNumbered:
+			return a.Number > b.Number
+		default:
+			return a.Date < b.Date
+		}
+	})
+}
+
+// expandLogPaths expands the glob patterns among the arguments and, with
+// rotated, adds the rotated files of every log. Each argument's files are
+// sorted chronologically; "-" is kept as is.
+func expandLogPaths(args []string, rotated bool) ([]string, error) {
+	var paths []string
+	seen := make(map[string]bool)
+	for _, arg := range args {
+		if arg == "-" {
+			paths = append(paths, arg)
+			continue
+		}
+
+		matches := []string{arg}
+		if strings.ContainsAny(arg, "*?[") {
+			var err error
+			matches, err = filepath.Glob(arg)
+			if err != nil {
+				return nil, fmt.Errorf("invalid pattern %s: %v", arg, err)
+			}
+			if len(matches) == 0 {
+				return nil, fmt.Errorf("no files match %s", arg)
+			}
+		}
+		if rotated {
+			var withRotated []string
+			for _, match := range matches {
+				withRotated = append(withRotated, match)
+				siblings, _ := filepath.Glob(filepath.Join(filepath.Dir(match), escapeGlob(filepath.Base(match))) + "?*")
+				for _, sibling := range siblings {
+					if parseRotationKey(sibling).Base == match {
+						withRotated = append(withRotated, sibling)
+					}
+				}
+			}
+			matches = withRotated
+		}
+
+		sortLogFilesChronologically(matches)
+		for _, match := range matches {
+			if !seen[match] {
+				seen[match] = true
+				paths = append(paths, match)
+			}
+		}
+	}
+	return paths, nil
+}
+
+// escapeGlob escapes the glob metacharacters of a file name
+func escapeGlob(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if strings.ContainsRune(`*?[\`, r) {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// forEachLogFile calls fn for every path on a bounded pool of jobs workers.
+// fn gets the path's index so results can be merged in input order; the
+// error of the first failing path is returned.
+func forEachLogFile(paths []string, jobs int, fn func(i int, path string) error) error {
+	if jobs < 1 {
+		jobs = runtime.NumCPU()
+	}
+	jobs = min(jobs, len(paths))
+
+	errs := make([]error, len(paths))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < jobs; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				errs[i] = fn(i, paths[i])
+			}
+		}()
+	}
+	for i := range paths {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
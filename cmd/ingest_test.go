@@ -0,0 +1,247 @@
+package cmd
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// A 503 log line compressed with bzip2, which the standard library cannot write
+const bzip2AccessLogLine = "QlpoOTFBWSZTWROTcQkAABVfgEAQUAv7MALAxAoIAAQAIABUUaGgABkBqjaepPTRGj1DIyjDBb7zrEsAukIX/cxNggt2OnkRIVfb5CGmqI2WBdolGw+++LuSKcKEgJybiEg="
+
+// gzipped compresses text with gzip
+func gzipped(t *testing.T, text string) []byte {
+	t.Helper()
+	var b bytes.Buffer
+	w := gzip.NewWriter(&b)
+	w.Write([]byte(text))
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes()
+}
+
+// zstdCompressed compresses text with zstd
+func zstdCompressed(t *testing.T, text string) []byte {
+	t.Helper()
+	var b bytes.Buffer
+	w, err := zstd.NewWriter(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Write([]byte(text))
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes()
+}
+
+func TestDecompressLog(t *testing.T) {
+	const line = `10.0.0.1 - - [19/Oct/2026:10:00:01 +0000] "GET / HTTP/1.1" 503 0` + "\n"
+	bzipped, err := base64.StdEncoding.DecodeString(bzip2AccessLogLine)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "plain", data: []byte(line)},
+		{name: "gzip", data: gzipped(t, line)},
+		{name: "zstd", data: zstdCompressed(t, line)},
+		{name: "bzip2", data: bzipped},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := decompressLog(bytes.NewReader(tt.data))
+			if err != nil {
+				t.Fatalf("decompressLog() error = %v", err)
+			}
+			defer r.Close()
+			got, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatalf("Reading %s log: %v", tt.name, err)
+			}
+			if string(got) != line {
+				t.Errorf("Decompressed %q, want %q", got, line)
+			}
+		})
+	}
+}
+
+func TestSortLogFilesChronologically(t *testing.T) {
+	paths := []string{
+		"access.log",
+		"access.log.1",
+		"error.log",
+		"access.log.10.gz",
+		"access.log.2.gz",
+		"access.log-20261019.zst",
+		"access.log-20261017.gz",
+		"error.log.1",
+	}
+	want := []string{
+		"access.log.10.gz",
+		"access.log.2.gz",
+		"access.log.1",
+		"access.log-20261017.gz",
+		"access.log-20261019.zst",
+		"access.log",
+		"error.log.1",
+		"error.log",
+	}
+
+	sortLogFilesChronologically(paths)
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("sortLogFilesChronologically() = %q, want %q", paths, want)
+	}
+}
+
+// writeRotatedLogs writes a rotated set of access.log with one line of a
+// distinct status code per file, returning the directory
+func writeRotatedLogs(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	line := func(status int) string {
+		return fmt.Sprintf("10.0.0.1 - - [19/Oct/2026:10:00:01 +0000] \"GET / HTTP/1.1\" %d 0\n", status)
+	}
+	files := map[string][]byte{
+		"access.log":        []byte(line(200)),
+		"access.log.1":      []byte(line(201)),
+		"access.log.2.gz":   gzipped(t, line(404)),
+		"access.log.3.zst":  zstdCompressed(t, line(500)),
+		"other.log":         []byte(line(302)),
+		"access.log.notes":  []byte("not a log\n"),
+		"access.log.backup": []byte(line(418)),
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestExpandLogPaths(t *testing.T) {
+	dir := writeRotatedLogs(t)
+	rel := func(paths []string) []string {
+		var names []string
+		for _, path := range paths {
+			names = append(names, filepath.Base(path))
+		}
+		return names
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		rotated bool
+		want    []string
+	}{
+		{
+			name: "plain file",
+			args: []string{filepath.Join(dir, "access.log")},
+			want: []string{"access.log"},
+		},
+		{
+			name:    "rotated set",
+			args:    []string{filepath.Join(dir, "access.log")},
+			rotated: true,
+			want:    []string{"access.log.3.zst", "access.log.2.gz", "access.log.1", "access.log"},
+		},
+		{
+			name: "glob",
+			args: []string{filepath.Join(dir, "access.log.[0-9]*")},
+			want: []string{"access.log.3.zst", "access.log.2.gz", "access.log.1"},
+		},
+		{
+			name:    "duplicates",
+			args:    []string{filepath.Join(dir, "access.log.1"), filepath.Join(dir, "access.log")},
+			rotated: true,
+			want:    []string{"access.log.1", "access.log.3.zst", "access.log.2.gz", "access.log"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			paths, err := expandLogPaths(tt.args, tt.rotated)
+			if err != nil {
+				t.Fatalf("expandLogPaths() error = %v", err)
+			}
+			if got := rel(paths); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expandLogPaths() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := expandLogPaths([]string{filepath.Join(dir, "*.missing")}, false); err == nil || !strings.Contains(err.Error(), "no files match") {
+		t.Errorf("Expected a no files match error, got %v", err)
+	}
+}
+
+func TestForEachLogFile(t *testing.T) {
+	paths := make([]string, 50)
+	for i := range paths {
+		paths[i] = fmt.Sprintf("file%d", i)
+	}
+
+	var running, peak int32
+	results := make([]string, len(paths))
+	err := forEachLogFile(paths, 4, func(i int, path string) error {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		defer atomic.AddInt32(&running, -1)
+		results[i] = path
+		if i == 7 || i == 30 {
+			return fmt.Errorf("cannot read %s", path)
+		}
+		return nil
+	})
+
+	if !reflect.DeepEqual(results, paths) {
+		t.Errorf("Results were not stored in input order: %q", results)
+	}
+	if peak > 4 {
+		t.Errorf("Expected at most 4 concurrent workers, got %d", peak)
+	}
+	if err == nil || err.Error() != "cannot read file7" {
+		t.Errorf("Expected the first failing path's error, got %v", err)
+	}
+}
+
+func TestStatsRotatedCompressedLogs(t *testing.T) {
+	dir := writeRotatedLogs(t)
+	defer func() { logRotated = false }()
+
+	stdout, _ := captureOutput(func() {
+		rootCmd.SetArgs([]string{"stats", "--rotated", "-j", "2", filepath.Join(dir, "access.log")})
+		rootCmd.Execute()
+	})
+
+	expected := []string{"Status Codes in 4 Requests", "200 OK", "201 Created", "404 Not Found", "500 Internal Server Error"}
+	for _, e := range expected {
+		if !strings.Contains(stdout, e) {
+			t.Errorf("Expected '%s' in output, got: %s", e, stdout)
+		}
+	}
+	if strings.Contains(stdout, "418") || strings.Contains(stdout, "302") {
+		t.Errorf("Expected only the rotated set of access.log, got: %s", stdout)
+	}
+}
@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Status of a code in one specification
+const (
+	revisionDeprecated = "deprecated"
+	revisionUnused     = "unused"
+	revisionRemoved    = "removed"
+)
+
+// specRevision is a status code's name in one specification
+type specRevision struct {
+	Spec   string
+	Name   string
+	Status string
+	Note   string
+}
+
+// Names of status codes across RFC 2616, RFC 7231 (with RFC 7232-7235) and
+// RFC 9110, plus the RFCs that introduced codes outside the core specs
+var statusCodeLineage = map[int][]specRevision{
+	100: {{"RFC 2616", "Continue", "", ""}, {"RFC 7231", "Continue", "", ""}, {"RFC 9110", "Continue", "", ""}},
+	101: {{"RFC 2616", "Switching Protocols", "", ""}, {"RFC 7231", "Switching Protocols", "", ""}, {"RFC 9110", "Switching Protocols", "", ""}},
+	102: {{"RFC 2518", "Processing", "", ""}, {"RFC 4918", "Processing", revisionRemoved, "dropped from WebDAV for lack of implementation; the registration remains"}},
+	103: {{"RFC 8297", "Early Hints", "", ""}},
+	200: {{"RFC 2616", "OK", "", ""}, {"RFC 7231", "OK", "", ""}, {"RFC 9110", "OK", "", ""}},
+	201: {{"RFC 2616", "Created", "", ""}, {"RFC 7231", "Created", "", ""}, {"RFC 9110", "Created", "", ""}},
+	202: {{"RFC 2616", "Accepted", "", ""}, {"RFC 7231", "Accepted", "", ""}, {"RFC 9110", "Accepted", "", ""}},
+	203: {{"RFC 2616", "Non-Authoritative Information", "", ""}, {"RFC 7231", "Non-Authoritative Information", "", ""}, {"RFC 9110", "Non-Authoritative Information", "", ""}},
+	204: {{"RFC 2616", "No Content", "", ""}, {"RFC 7231", "No Content", "", ""}, {"RFC 9110", "No Content", "", ""}},
+	205: {{"RFC 2616", "Reset Content", "", ""}, {"RFC 7231", "Reset Content", "", ""}, {"RFC 9110", "Reset Content", "", ""}},
+	206: {{"RFC 2616", "Partial Content", "", ""}, {"RFC 7233", "Partial Content", "", ""}, {"RFC 9110", "Partial Content", "", ""}},
+	207: {{"RFC 2518", "Multi-Status", "", ""}, {"RFC 4918", "Multi-Status", "", ""}},
+	208: {{"RFC 5842", "Already Reported", "", ""}},
+	226: {{"RFC 3229", "IM Used", "", ""}},
+	300: {{"RFC 2616", "Multiple Choices", "", ""}, {"RFC 7231", "Multiple Choices", "", ""}, {"RFC 9110", "Multiple Choices", "", ""}},
+	301: {{"RFC 2616", "Moved Permanently", "", ""}, {"RFC 7231", "Moved Permanently", "", ""}, {"RFC 9110", "Moved Permanently", "", ""}},
+	302: {{"RFC 1945", "Moved Temporarily", "", ""}, {"RFC 2616", "Found", "", "renamed; clients were changing POST to GET anyway, so 303 and 307 were added"}, {"RFC 7231", "Found", "", ""}, {"RFC 9110", "Found", "", ""}},
+	303: {{"RFC 2616", "See Other", "", ""}, {"RFC 7231", "See Other", "", ""}, {"RFC 9110", "See Other", "", ""}},
+	304: {{"RFC 2616", "Not Modified", "", ""}, {"RFC 7232", "Not Modified", "", ""}, {"RFC 9110", "Not Modified", "", ""}},
+	305: {{"RFC 2616", "Use Proxy", "", ""}, {"RFC 7231", "Use Proxy", revisionDeprecated, "in-band proxy configuration is a security risk"}, {"RFC 9110", "Use Proxy", revisionDeprecated, ""}},
+	306: {{"RFC 2616", "(Unused)", revisionUnused, "previously \"Switch Proxy\" in drafts; the code is reserved"}, {"RFC 7231", "(Unused)", revisionUnused, ""}, {"RFC 9110", "(Unused)", revisionUnused, ""}},
+	307: {{"RFC 2616", "Temporary Redirect", "", ""}, {"RFC 7231", "Temporary Redirect", "", ""}, {"RFC 9110", "Temporary Redirect", "", ""}},
+	308: {{"RFC 7238", "Permanent Redirect", "", "experimental"}, {"RFC 7538", "Permanent Redirect", "", ""}, {"RFC 9110", "Permanent Redirect", "", "moved into the core spec"}},
+	400: {{"RFC 2616", "Bad Request", "", ""}, {"RFC 7231", "Bad Request", "", ""}, {"RFC 9110", "Bad Request", "", ""}},
+	401: {{"RFC 2616", "Unauthorized", "", ""}, {"RFC 7235", "Unauthorized", "", ""}, {"RFC 9110", "Unauthorized", "", ""}},
+	402: {{"RFC 2616", "Payment Required", "", "reserved for future use"}, {"RFC 7231", "Payment Required", "", ""}, {"RFC 9110", "Payment Required", "", ""}},
+	403: {{"RFC 2616", "Forbidden", "", ""}, {"RFC 7231", "Forbidden", "", ""}, {"RFC 9110", "Forbidden", "", ""}},
+	404: {{"RFC 2616", "Not Found", "", ""}, {"RFC 7231", "Not Found", "", ""}, {"RFC 9110", "Not Found", "", ""}},
+	405: {{"RFC 2616", "Method Not Allowed", "", ""}, {"RFC 7231", "Method Not Allowed", "", ""}, {"RFC 9110", "Method Not Allowed", "", ""}},
+	406: {{"RFC 2616", "Not Acceptable", "", ""}, {"RFC 7231", "Not Acceptable", "", ""}, {"RFC 9110", "Not Acceptable", "", ""}},
+	407: {{"RFC 2616", "Proxy Authentication Required", "", ""}, {"RFC 7235", "Proxy Authentication Required", "", ""}, {"RFC 9110", "Proxy Authentication Required", "", ""}},
+	408: {{"RFC 2616", "Request Time-out", "", ""}, {"RFC 7231", "Request Timeout", "", ""}, {"RFC 9110", "Request Timeout", "", ""}},
+	409: {{"RFC 2616", "Conflict", "", ""}, {"RFC 7231", "Conflict", "", ""}, {"RFC 9110", "Conflict", "", ""}},
+	410: {{"RFC 2616", "Gone", "", ""}, {"RFC 7231", "Gone", "", ""}, {"RFC 9110", "Gone", "", ""}},
+	411: {{"RFC 2616", "Length Required", "", ""}, {"RFC 7231", "Length Required", "", ""}, {"RFC 9110", "Length Required", "", ""}},
+	412: {{"RFC 2616", "Precondition Failed", "", ""}, {"RFC 7232", "Precondition Failed", "", ""}, {"RFC 9110", "Precondition Failed", "", ""}},
+	413: {{"RFC 2616", "Request Entity Too Large", "", ""}, {"RFC 7231", "Payload Too Large", "", "HTTP/1.1 no longer calls message bodies entities"}, {"RFC 9110", "Content Too Large", "", "payload renamed to content"}},
+	414: {{"RFC 2616", "Request-URI Too Long", "", ""}, {"RFC 7231", "URI Too Long", "", ""}, {"RFC 9110", "URI Too Long", "", ""}},
+	415: {{"RFC 2616", "Unsupported Media Type", "", ""}, {"RFC 7231", "Unsupported Media Type", "", ""}, {"RFC 9110", "Unsupported Media Type", "", "also covers unsupported Content-Encoding"}},
+	416: {{"RFC 2616", "Requested Range Not Satisfiable", "", ""}, {"RFC 7233", "Range Not Satisfiable", "", ""}, {"RFC 9110", "Range Not Satisfiable", "", ""}},
+	417: {{"RFC 2616", "Expectation Failed", "", ""}, {"RFC 7231", "Expectation Failed", "", ""}, {"RFC 9110", "Expectation Failed", "", ""}},
+	418: {{"RFC 2324", "I'm a teapot", "", "April Fools' joke (HTCPCP)"}, {"RFC 9110", "(Unused)", revisionUnused, "reserved so it is never assigned"}},
+	421: {{"RFC 7540", "Misdirected Request", "", "introduced with HTTP/2"}, {"RFC 9110", "Misdirected Request", "", "moved into the core spec"}},
+	422: {{"RFC 2518", "Unprocessable Entity", "", ""}, {"RFC 4918", "Unprocessable Entity", "", ""}, {"RFC 9110", "Unprocessable Content", "", "moved into the core spec and generalized beyond WebDAV"}},
+	423: {{"RFC 2518", "Locked", "", ""}, {"RFC 4918", "Locked", "", ""}},
+	424: {{"RFC 2518", "Failed Dependency", "", ""}, {"RFC 4918", "Failed Dependency", "", ""}},
+	425: {{"RFC 8470", "Too Early", "", ""}},
+	426: {{"RFC 2817", "Upgrade Required", "", ""}, {"RFC 7231", "Upgrade Required", "", ""}, {"RFC 9110", "Upgrade Required", "", ""}},
+	428: {{"RFC 6585", "Precondition Required", "", ""}},
+	429: {{"RFC 6585", "Too Many Requests", "", ""}},
+	431: {{"RFC 6585", "Request Header Fields Too Large", "", ""}},
+	451: {{"RFC 7725", "Unavailable For Legal Reasons", "", ""}},
+	500: {{"RFC 2616", "Internal Server Error", "", ""}, {"RFC 7231", "Internal Server Error", "", ""}, {"RFC 9110", "Internal Server Error", "", ""}},
+	501: {{"RFC 2616", "Not Implemented", "", ""}, {"RFC 7231", "Not Implemented", "", ""}, {"RFC 9110", "Not Implemented", "", ""}},
+	502: {{"RFC 2616", "Bad Gateway", "", ""}, {"RFC 7231", "Bad Gateway", "", ""}, {"RFC 9110", "Bad Gateway", "", ""}},
+	503: {{"RFC 2616", "Service Unavailable", "", ""}, {"RFC 7231", "Service Unavailable", "", ""}, {"RFC 9110", "Service Unavailable", "", ""}},
+	504: {{"RFC 2616", "Gateway Time-out", "", ""}, {"RFC 7231", "Gateway Timeout", "", ""}, {"RFC 9110", "Gateway Timeout", "", ""}},
+	505: {{"RFC 2616", "HTTP Version not supported", "", ""}, {"RFC 7231", "HTTP Version Not Supported", "", ""}, {"RFC 9110", "HTTP Version Not Supported", "", ""}},
+	506: {{"RFC 2295", "Variant Also Negotiates", "", "experimental"}},
+	507: {{"RFC 2518", "Insufficient Storage", "", ""}, {"RFC 4918", "Insufficient Storage", "", ""}},
+	508: {{"RFC 5842", "Loop Detected", "", ""}},
+	510: {{"RFC 2774", "Not Extended", "", "experimental"}},
+	511: {{"RFC 6585", "Network Authentication Required", "", ""}},
+}
+
+// Reverse index from normalized current and historical names to status codes
+var statusNameIndex = func() map[string]int {
+	index := make(map[string]int)
+	for code, revisions := range statusCodeLineage {
+		for _, revision := range revisions {
+			if revision.Status != revisionUnused {
+				index[normalizeStatusName(revision.Name)] = code
+			}
+		}
+	}
+	for code, info := range httpCodesInfo {
+		index[normalizeStatusName(info.Description)] = code
+	}
+	return index
+}()
+
+// normalizeStatusName reduces a status name to lowercase letters and digits,
+// so "Request-URI Too Long", "request uri too long" and "RequestURITooLong"
+// compare equal
+func normalizeStatusName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// resolveStatusName returns the status code for a current or historical name
+func resolveStatusName(name string) (int, bool) {
+	code, exists := statusNameIndex[normalizeStatusName(name)]
+	return code, exists && normalizeStatusName(name) != ""
+}
+
+// formerStatusNames returns the names a status code had before its current
+// name, oldest first
+func formerStatusNames(code int) []string {
+	current := normalizeStatusName(httpCodesInfo[code].Description)
+	seen := map[string]bool{current: true}
+
+	var names []string
+	for _, revision := range statusCodeLineage[code] {
+		key := normalizeStatusName(revision.Name)
+		if seen[key] || revision.Status == revisionUnused {
+			continue
+		}
+		seen[key] = true
+		names = append(names, revision.Name)
+	}
+	return names
+}
+
+// historicalStatusCodes returns the codes with a recorded lineage in order
+func historicalStatusCodes() []int {
+	var codes []int
+	for code := range statusCodeLineage {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+	return codes
+}
@@ -16,15 +16,26 @@ var listCmd = &cobra.Command{
 Categories are: 1xx, 2xx, 3xx, 4xx, 5xx`,
 	ValidArgs: []string{"1xx", "2xx", "3xx", "4xx", "5xx"},
 	Run: func(cmd *cobra.Command, args []string) {
+		category := ""
 		if len(args) > 0 {
-			listCodes(args[0])
-		} else {
-			listCodes("")
+			category = args[0]
 		}
+		if !selectDescriptionLocale() {
+			return
+		}
+		if protocol, isProtocol, valid := selectedReplyProtocol(); !valid {
+			return
+		} else if isProtocol {
+			listProtocolCodes(protocol, category)
+			return
+		}
+		listCodes(category)
 	},
 }
 
 func init() {
+	listCmd.Flags().StringVar(&protoFlag, "proto", "", protoFlagUsage)
+	listCmd.Flags().StringVar(&descriptionLangFlag, "lang", "", descriptionLangFlagUsage)
 	rootCmd.AddCommand(listCmd)
 }
 
@@ -63,7 +74,7 @@ func listCodes(category string) {
 			
 			// Display codes in this category
 			for _, code := range codes {
-				displayCodeListItemWithLipgloss(code, httpCodesInfo[code].Description)
+				displayCodeListItemWithLipgloss(code, localizedCodeInfo(code).Description)
 			}
 		}
 		return
@@ -115,6 +126,6 @@ func listCodes(category string) {
 	
 	// Display codes in this category
 	for _, code := range codes {
-		displayCodeListItemWithLipgloss(code, httpCodesInfo[code].Description)
+		displayCodeListItemWithLipgloss(code, localizedCodeInfo(code).Description)
 	}
 }
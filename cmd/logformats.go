@@ -0,0 +1,438 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// logFormat parses the access log lines of one format
+type logFormat struct {
+	Name        string
+	Description string
+	Parse       func(line string) (logRecord, bool)
+}
+
+// jsonLogFields are the dotted field paths of a JSON log format; the first
+// path present in a line wins
+type jsonLogFields struct {
+	Status         []string
+	Method         []string
+	Path           []string
+	Time           []string
+	UpstreamStatus []string
+	UpstreamLength []string
+	Flags          []string
+}
+
+// logFields is the text of the fields of one log line
+type logFields struct {
+	Status         string
+	Method         string
+	Path           string
+	Time           string
+	UpstreamStatus string
+	UpstreamLength string
+	Flags          string
+}
+
+// Number of lines used to detect the format of a log
+const logDetectLines = 20
+
+// Timestamp layout of $time_local and Apache %t
+const combinedLogTimeLayout = "02/Jan/2006:15:04:05 -0700"
+
+// Longest log line parsed; longer lines, e.g. with huge query strings, are
+// skipped without stopping the scan
+const maxLogLineSize = 1024 * 1024
+
+// Log format selected with --format; empty or "auto" detects the format
+var logFormatFlag string
+
+// logFormatFlagUsage is the help text of the --format flag
+const logFormatFlagUsage = "log format: auto, ingress-nginx, combined, caddy, traefik, envoy, alb, cloudfront, json or a format from the config file"
+
+// nginx/Apache common and combined log format, also used by Traefik's CLF
+// access logs: $remote_addr - $remote_user [$time_local] "$request" $status ...
+var combinedLogFormat = regexLogFormat("combined", "nginx/Apache common and combined log format",
+	`^\S+ \S+ .*?\[(?P<time>[^\]]+)\] "(?P<request>(?:[^"\\]|\\.)*)" (?P<status>\d{3})(?:\s|$)`,
+	combinedLogTimeLayout)
+
+// ingress-nginx default log format, the combined format followed by
+// $request_length $request_time [$proxy_upstream_name]
+// [$proxy_alternative_upstream_name] $upstream_addr
+// $upstream_response_length $upstream_response_time $upstream_status $req_id.
+// The upstream fields list every upstream tried, e.g. "502, 504".
+var ingressNginxLogFormat = regexLogFormat("ingress-nginx", "Kubernetes ingress-nginx log format, with upstream status",
+	`^\S+ \S+ .*?\[(?P<time>[^\]]+)\] "(?P<request>(?:[^"\\]|\\.)*)" (?P<status>\d{3}) \S+ "(?:[^"\\]|\\.)*" "(?:[^"\\]|\\.)*" \S+ \S+ \[[^\]]*\] \[[^\]]*\] `+
+		upstreamListPattern("upstream_addr")+` `+upstreamListPattern("upstream_length")+` `+upstreamListPattern("upstream_time")+` `+upstreamListPattern("upstream_status")+` \S+$`,
+	combinedLogTimeLayout)
+
+// upstreamListPattern matches a named nginx upstream variable, which lists one
+// value per upstream tried, separated by ", " or " : "
+func upstreamListPattern(name string) string {
+	return `(?P<` + name + `>[^\s,]+(?:(?:, | : )[^\s,]+)*)`
+}
+
+// Built-in log format presets, in detection order; stricter formats come
+// first as they win ties
+var logFormatPresets = []logFormat{
+	ingressNginxLogFormat,
+	combinedLogFormat,
+	jsonLogFormat("caddy", "Caddy JSON access logs", jsonLogFields{
+		Status: []string{"status"},
+		Method: []string{"request.method"},
+		Path:   []string{"request.uri"},
+		Time:   []string{"ts"},
+	}, "", true),
+	jsonLogFormat("traefik", "Traefik JSON access logs (CLF logs use combined)", jsonLogFields{
+		Status: []string{"DownstreamStatus"},
+		Method: []string{"RequestMethod"},
+		Path:   []string{"RequestPath"},
+		Time:   []string{"StartUTC", "time"},
+		// 0 when no upstream responded
+		UpstreamStatus: []string{"OriginStatus"},
+	}, "", true),
+	regexLogFormat("envoy", "Envoy default access log format",
+		`^\[(?P<time>[^\]]+)\] "(?P<request>[^"]*)" (?P<status>\d{3}|0) (?P<flags>\S+) `,
+		""),
+	regexLogFormat("alb", "AWS Application Load Balancer access logs",
+		`^(?:https?|h2|grpcs|wss?) (?P<time>\S+) \S+ \S+ \S+ \S+ \S+ \S+ (?P<status>\d{3}) \S+ \S+ \S+ "(?P<request>[^"]*)"`,
+		""),
+	regexLogFormat("cloudfront", "Amazon CloudFront standard logs",
+		`^(?P<time>\d{4}-\d{2}-\d{2}\t\d{2}:\d{2}:\d{2})\t[^\t]*\t[^\t]*\t[^\t]*\t(?P<method>[^\t]*)\t[^\t]*\t(?P<path>[^\t]*)\t(?P<status>\d{3})\t`,
+		"2006-01-02\t15:04:05"),
+	jsonLogFormat("json", "JSON lines with a status field", jsonLogFields{
+		Status:         []string{"status", "status_code", "statusCode", "http.status_code", "response.status"},
+		Method:         []string{"method", "request.method", "http.method"},
+		Path:           []string{"path", "uri", "url", "request.uri", "request.path", "http.path"},
+		Time:           []string{"time", "timestamp", "ts", "@timestamp"},
+		UpstreamStatus: []string{"upstream_status", "upstreamStatus", "upstream.status"},
+	}, "", false),
+}
+
+// regexLogFormat builds a log format from a regular expression with the named
+// groups status and optionally time, method, path or request (a request line
+// such as "GET /path HTTP/1.1")
+func regexLogFormat(name, description, pattern, timeLayout string) logFormat {
+	re := regexp.MustCompile(pattern)
+	return newRegexLogFormat(name, description, re, timeLayout)
+}
+
+func newRegexLogFormat(name, description string, re *regexp.Regexp, timeLayout string) logFormat {
+	groups := make(map[string]int)
+	for i, group := range re.SubexpNames() {
+		if group != "" {
+			groups[group] = i
+		}
+	}
+	field := func(match []string, group string) string {
+		if i, ok := groups[group]; ok {
+			return match[i]
+		}
+		return ""
+	}
+
+	return logFormat{
+		Name:        name,
+		Description: description,
+		Parse: func(line string) (logRecord, bool) {
+			match := re.FindStringSubmatch(line)
+			if match == nil {
+				return logRecord{}, false
+			}
+			fields := logFields{
+				Status:         field(match, "status"),
+				Method:         field(match, "method"),
+				Path:           field(match, "path"),
+				Time:           field(match, "time"),
+				UpstreamStatus: field(match, "upstream_status"),
+				UpstreamLength: field(match, "upstream_length"),
+				Flags:          field(match, "flags"),
+			}
+			if request := strings.Fields(field(match, "request")); len(request) >= 2 {
+				fields.Method, fields.Path = request[0], request[1]
+			}
+			return buildLogRecord(fields, timeLayout)
+		},
+	}
+}
+
+// jsonLogFormat builds a log format for JSON lines. A strict format only
+// matches lines that have its status, method, path and time fields.
+func jsonLogFormat(name, description string, fields jsonLogFields, timeLayout string, strict bool) logFormat {
+	return logFormat{
+		Name:        name,
+		Description: description,
+		Parse: func(line string) (logRecord, bool) {
+			if !strings.HasPrefix(strings.TrimSpace(line), "{") {
+				return logRecord{}, false
+			}
+			decoder := json.NewDecoder(strings.NewReader(line))
+			decoder.UseNumber()
+			var object map[string]any
+			if err := decoder.Decode(&object); err != nil {
+				return logRecord{}, false
+			}
+
+			var values logFields
+			for _, field := range []struct {
+				value *string
+				paths []string
+			}{
+				{&values.Status, fields.Status},
+				{&values.Method, fields.Method},
+				{&values.Path, fields.Path},
+				{&values.Time, fields.Time},
+			} {
+				value, found := jsonFieldValue(object, field.paths)
+				if !found && strict && len(field.paths) > 0 {
+					return logRecord{}, false
+				}
+				*field.value = value
+			}
+			values.UpstreamStatus, _ = jsonFieldValue(object, fields.UpstreamStatus)
+			values.UpstreamLength, _ = jsonFieldValue(object, fields.UpstreamLength)
+			values.Flags, _ = jsonFieldValue(object, fields.Flags)
+			return buildLogRecord(values, timeLayout)
+		},
+	}
+}
+
+// jsonFieldValue returns the value of the first dotted path present in a
+// decoded JSON object
+func jsonFieldValue(object map[string]any, paths []string) (string, bool) {
+	for _, path := range paths {
+		var value any = object
+		for _, key := range strings.Split(path, ".") {
+			nested, ok := value.(map[string]any)
+			if !ok {
+				value = nil
+				break
+			}
+			value = nested[key]
+		}
+		if value != nil {
+			return fmt.Sprint(value), true
+		}
+	}
+	return "", false
+}
+
+// buildLogRecord builds a record from the text of its fields
+func buildLogRecord(fields logFields, timeLayout string) (logRecord, bool) {
+	code, err := strconv.Atoi(fields.Status)
+	// Envoy logs status 0 with response flags such as DC when no response
+	// was sent
+	noResponse := code == 0 && len(splitEnvoyFlags(fields.Flags)) > 0
+	if err != nil || (!noResponse && (code < 100 || code > 999)) {
+		return logRecord{}, false
+	}
+
+	path := fields.Path
+	// Load balancers log the absolute URL; keep the path and query
+	if strings.Contains(path, "://") {
+		if u, err := url.Parse(path); err == nil {
+			path = u.RequestURI()
+		}
+	}
+	return logRecord{
+		Time:           parseLogTime(fields.Time, timeLayout),
+		Method:         fields.Method,
+		Path:           path,
+		Status:         code,
+		UpstreamStatus: fields.UpstreamStatus,
+		UpstreamLength: fields.UpstreamLength,
+		Flags:          fields.Flags,
+	}, true
+}
+
+// parseLogTime parses a timestamp with a layout, or as RFC 3339, the combined
+// log format or Unix seconds when the layout is empty
+func parseLogTime(value, layout string) time.Time {
+	if value == "" {
+		return time.Time{}
+	}
+	if layout != "" {
+		t, _ := time.Parse(layout, value)
+		return t
+	}
+	for _, layout := range []string{time.RFC3339Nano, combinedLogTimeLayout} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t
+		}
+	}
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+		// Milliseconds since the epoch are common in JSON logs too
+		if seconds > 1e11 {
+			seconds /= 1000
+		}
+		whole, fraction := math.Modf(seconds)
+		return time.Unix(int64(whole), int64(fraction*1e9)).UTC()
+	}
+	return time.Time{}
+}
+
+// selectLogFormats returns the formats to parse logs with: the one selected
+// by --format, or every user and preset format for detection
+func selectLogFormats() ([]logFormat, error) {
+	userFormats, err := loadUserLogFormats()
+	if err != nil {
+		return nil, err
+	}
+	formats := append(userFormats, logFormatPresets...)
+
+	if logFormatFlag == "" || logFormatFlag == "auto" {
+		return formats, nil
+	}
+	for _, format := range formats {
+		if strings.EqualFold(format.Name, logFormatFlag) {
+			return []logFormat{format}, nil
+		}
+	}
+
+	var names []string
+	for _, format := range formats {
+		names = append(names, format.Name)
+	}
+	return nil, fmt.Errorf("unknown log format %s. Use auto, %s", logFormatFlag, strings.Join(names, ", "))
+}
+
+// detectLogFormat returns the format that parses the most sample lines,
+// preferring earlier formats on a tie
+func detectLogFormat(lines []string, formats []logFormat) (logFormat, bool) {
+	best, bestCount := logFormat{}, 0
+	for _, format := range formats {
+		count := 0
+		for _, line := range lines {
+			if _, ok := format.Parse(line); ok {
+				count++
+			}
+		}
+		if count > bestCount {
+			best, bestCount = format, count
+		}
+	}
+	return best, bestCount > 0
+}
+
+// isLogComment reports whether a line carries no record, such as the
+// #Version and #Fields headers of CloudFront logs
+func isLogComment(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return trimmed == "" || strings.HasPrefix(trimmed, "#")
+}
+
+// scanLogRecords parses every record of a log, detecting its format from the
+// first lines. It returns the number of lines that could not be parsed.
+func scanLogRecords(r io.Reader, formats []logFormat, fn func(logRecord)) (int, error) {
+	var format logFormat
+	var pending []string
+	skipped := 0
+	parse := func(line string) {
+		if record, ok := format.Parse(line); ok {
+			fn(record)
+		} else {
+			skipped++
+		}
+	}
+	detect := func() {
+		if detected, ok := detectLogFormat(pending, formats); ok {
+			format = detected
+			for _, line := range pending {
+				parse(line)
+			}
+		} else {
+			skipped += len(pending)
+		}
+		pending = nil
+	}
+
+	oversized, err := readLogLines(r, func(line string) {
+		if isLogComment(line) {
+			return
+		}
+		if format.Parse != nil {
+			parse(line)
+			return
+		}
+		pending = append(pending, line)
+		if len(pending) == logDetectLines {
+			detect()
+		}
+	})
+	if len(pending) > 0 {
+		detect()
+	}
+	return skipped + oversized, err
+}
+
+// readLogLines calls fn for every line of a log, without its line ending.
+// Lines longer than maxLogLineSize are dropped; it returns how many.
+func readLogLines(r io.Reader, fn func(line string)) (int, error) {
+	reader := bufio.NewReaderSize(r, 64*1024)
+	oversized := 0
+	var line []byte
+	tooLong := false
+	for {
+		chunk, err := reader.ReadSlice('\n')
+		if err == bufio.ErrBufferFull {
+			// Keep reading the line, but only buffer it up to the limit
+			if !tooLong && len(line)+len(chunk) > maxLogLineSize {
+				line, tooLong = nil, true
+			}
+			if !tooLong {
+				line = append(line, chunk...)
+			}
+			continue
+		}
+		if err != nil && err != io.EOF {
+			return oversized, err
+		}
+
+		if !tooLong {
+			line = append(line, chunk...)
+			line = bytes.TrimSuffix(bytes.TrimSuffix(line, []byte("\n")), []byte("\r"))
+			tooLong = len(line) > maxLogLineSize
+		}
+		if tooLong {
+			oversized++
+		} else if len(chunk) > 0 || len(line) > 0 {
+			fn(string(line))
+		}
+		line, tooLong = line[:0], false
+
+		if err == io.EOF {
+			return oversized, nil
+		}
+	}
+}
+
+// streamLogParser parses the lines of a live log one at a time, locking onto
+// the first format that parses a line
+type streamLogParser struct {
+	formats []logFormat
+	format  *logFormat
+}
+
+// parse parses one line, detecting the format on the first parsable line
+func (p *streamLogParser) parse(line string) (logRecord, bool) {
+	if p.format != nil {
+		return p.format.Parse(line)
+	}
+	for i := range p.formats {
+		if record, ok := p.formats[i].Parse(line); ok {
+			p.format = &p.formats[i]
+			return record, true
+		}
+	}
+	return logRecord{}, false
+}
@@ -0,0 +1,316 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Sample lines of every preset log format
+var sampleLogFormatLines = map[string]string{
+	"ingress-nginx": `10.0.0.1 - - [19/Oct/2026:10:00:01 +0000] "GET /users/42 HTTP/1.1" 404 64 "-" "curl/8.5.0" 120 0.002 [default-api-80] [] 10.244.0.5:8080, 10.244.0.6:8080 0, 64 0.001, 0.001 502, 404 5f2c9a7e1b3d4c6a8e0f1a2b3c4d5e6f`,
+	"combined":      `10.0.0.1 - - [19/Oct/2026:10:00:01 +0000] "GET /users/42 HTTP/1.1" 404 64 "-" "curl/8.5.0"`,
+	"caddy":         `{"level":"info","ts":1792404001.5,"logger":"http.log.access","msg":"handled request","request":{"remote_ip":"10.0.0.1","proto":"HTTP/2.0","method":"GET","host":"example.com","uri":"/users/42"},"duration":0.001,"size":64,"status":404}`,
+	"traefik":       `{"ClientHost":"10.0.0.1","DownstreamStatus":404,"RequestMethod":"GET","RequestPath":"/users/42","StartUTC":"2026-10-19T10:00:01.5Z","level":"info"}`,
+	"envoy":         `[2026-10-19T10:00:01.500Z] "GET /users/42 HTTP/1.1" 404 NR 0 0 0 - "10.0.0.1" "curl/8.5.0" "a1b2" "example.com" "-"`,
+	"alb":           `https 2026-10-19T10:00:01.500000Z app/my-lb/50dc6c495c0c9188 10.0.0.1:2817 10.0.1.5:80 0.000 0.001 0.000 404 404 34 366 "GET https://example.com:443/users/42 HTTP/1.1" "curl/8.5.0" - -`,
+	"cloudfront":    "2026-10-19\t10:00:01\tSEA19-C1\t64\t10.0.0.1\tGET\td111111abcdef8.cloudfront.net\t/users/42\t404\t-\tcurl/8.5.0\t-\t-\tError",
+	"json":          `{"time":"2026-10-19T10:00:01Z","method":"GET","path":"/users/42","status":"404"}`,
+}
+
+func TestLogFormatPresets(t *testing.T) {
+	for _, format := range logFormatPresets {
+		t.Run(format.Name, func(t *testing.T) {
+			line, ok := sampleLogFormatLines[format.Name]
+			if !ok {
+				t.Fatalf("No sample line for log format %s", format.Name)
+			}
+			record, ok := format.Parse(line)
+			if !ok {
+				t.Fatalf("%s did not parse %q", format.Name, line)
+			}
+			if record.Status != 404 || record.Method != "GET" || record.Path != "/users/42" {
+				t.Errorf("%s parsed %+v, want 404 GET /users/42", format.Name, record)
+			}
+			if record.Time.IsZero() || record.Time.Year() != 2026 {
+				t.Errorf("%s parsed time %v, want a time in 2026", format.Name, record.Time)
+			}
+		})
+	}
+}
+
+func TestLogFormatUpstreamFields(t *testing.T) {
+	tests := []struct {
+		format             string
+		line               string
+		wantUpstreamStatus string
+		wantUpstreamLength string
+		wantFlags          string
+	}{
+		{
+			format:             "ingress-nginx",
+			line:               sampleLogFormatLines["ingress-nginx"],
+			wantUpstreamStatus: "502, 404",
+			wantUpstreamLength: "0, 64",
+		},
+		{
+			format:             "ingress-nginx",
+			line:               `10.0.0.1 - - [19/Oct/2026:10:00:01 +0000] "GET / HTTP/1.1" 503 190 "-" "curl/8.5.0" 80 0.000 [default-api-80] [] - - - - 9f8e7d6c5b4a39281706f5e4d3c2b1a0`,
+			wantUpstreamStatus: "-",
+			wantUpstreamLength: "-",
+		},
+		{
+			format:             "traefik",
+			line:               `{"DownstreamStatus":502,"OriginStatus":0,"RequestMethod":"GET","RequestPath":"/","StartUTC":"2026-10-19T10:00:01Z"}`,
+			wantUpstreamStatus: "0",
+		},
+		{
+			format:    "envoy",
+			line:      `[2026-10-19T10:00:01.500Z] "GET / HTTP/1.1" 503 UF,URX 0 91 30 - "10.0.0.1" "curl/8.5.0" "a1b2" "example.com" "10.0.1.5:8080"`,
+			wantFlags: "UF,URX",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			formats, _ := selectLogFormats()
+			for _, format := range formats {
+				if format.Name != tt.format {
+					continue
+				}
+				record, ok := format.Parse(tt.line)
+				if !ok {
+					t.Fatalf("%s did not parse %q", tt.format, tt.line)
+				}
+				if record.UpstreamStatus != tt.wantUpstreamStatus || record.UpstreamLength != tt.wantUpstreamLength || record.Flags != tt.wantFlags {
+					t.Errorf("%s parsed upstream %q, length %q, flags %q", tt.format, record.UpstreamStatus, record.UpstreamLength, record.Flags)
+				}
+			}
+		})
+	}
+}
+
+func TestDetectLogFormat(t *testing.T) {
+	for name, line := range sampleLogFormatLines {
+		t.Run(name, func(t *testing.T) {
+			format, ok := detectLogFormat([]string{line, line}, logFormatPresets)
+			if !ok || format.Name != name {
+				t.Errorf("detectLogFormat() = %s, %v, want %s", format.Name, ok, name)
+			}
+		})
+	}
+
+	if _, ok := detectLogFormat([]string{"not an access log line"}, logFormatPresets); ok {
+		t.Errorf("detectLogFormat() detected a format for garbage")
+	}
+}
+
+func TestScanLogRecordsSkipsComments(t *testing.T) {
+	log := "#Version: 1.0\n#Fields: date time x-edge-location\n" +
+		sampleLogFormatLines["cloudfront"] + "\n" + "garbage\n"
+
+	var records []logRecord
+	skipped, err := scanLogRecords(strings.NewReader(log), logFormatPresets, func(r logRecord) {
+		records = append(records, r)
+	})
+	if err != nil {
+		t.Fatalf("scanLogRecords() error = %v", err)
+	}
+	if len(records) != 1 || skipped != 1 {
+		t.Errorf("Expected 1 record and 1 skipped line, got %d and %d", len(records), skipped)
+	}
+}
+
+func TestScanLogRecordsSkipsOversizedLines(t *testing.T) {
+	line := sampleLogFormatLines["combined"]
+	tests := []struct {
+		name string
+		log  string
+	}{
+		{"between lines", line + "\n" + strings.Repeat("x", 2*maxLogLineSize) + "\n" + line + "\n"},
+		{"last line", line + "\n" + line + "\r\n" + strings.Repeat("x", maxLogLineSize+1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var records []logRecord
+			skipped, err := scanLogRecords(strings.NewReader(tt.log), logFormatPresets, func(r logRecord) {
+				records = append(records, r)
+			})
+			if err != nil {
+				t.Fatalf("scanLogRecords() error = %v", err)
+			}
+			if len(records) != 2 || skipped != 1 {
+				t.Errorf("Expected 2 records and 1 skipped line, got %d and %d", len(records), skipped)
+			}
+		})
+	}
+}
+
+func TestParseLogTime(t *testing.T) {
+	want := time.Date(2026, 10, 19, 10, 0, 1, 0, time.UTC)
+	tests := []struct {
+		value  string
+		layout string
+	}{
+		{value: "2026-10-19T10:00:01Z"},
+		{value: "19/Oct/2026:10:00:01 +0000"},
+		{value: "1792404001"},
+		{value: "1792404001000"},
+		{value: "2026-10-19 10:00:01", layout: "2006-01-02 15:04:05"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := parseLogTime(tt.value, tt.layout); !got.Equal(want) {
+				t.Errorf("parseLogTime(%q) = %v, want %v", tt.value, got, want)
+			}
+		})
+	}
+}
+
+// writeTestConfig writes a config file and points --config at it
+func writeTestConfig(t *testing.T, content string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "httpcode.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cfgFile = path
+	t.Cleanup(func() { cfgFile = "" })
+}
+
+func TestUserLogFormats(t *testing.T) {
+	writeTestConfig(t, `log_formats:
+  - name: myapp
+    pattern: '^(?P<time>\S+ \S+) (?P<method>\S+) (?P<path>\S+) -> (?P<status>\d{3})$'
+    time_layout: '2006-01-02 15:04:05'
+  - name: applog
+    json:
+      status: http.status_code
+      method: http.method
+      path: http.target
+      upstream_status: upstream.status
+      upstream_length: upstream.bytes
+      response_flags: envoy.flags
+`)
+
+	formats, err := loadUserLogFormats()
+	if err != nil {
+		t.Fatalf("loadUserLogFormats() error = %v", err)
+	}
+	if len(formats) != 2 {
+		t.Fatalf("Expected 2 user log formats, got %d", len(formats))
+	}
+
+	record, ok := formats[0].Parse("2026-10-19 10:00:01 GET /users/42 -> 503")
+	if !ok || record.Status != 503 || record.Path != "/users/42" || record.Time.Hour() != 10 {
+		t.Errorf("myapp parsed %+v, %v", record, ok)
+	}
+	record, ok = formats[1].Parse(`{"http":{"status_code":201,"method":"POST","target":"/orders"}}`)
+	if !ok || record.Status != 201 || record.Method != "POST" || record.Path != "/orders" {
+		t.Errorf("applog parsed %+v, %v", record, ok)
+	}
+	record, ok = formats[1].Parse(`{"http":{"status_code":503,"target":"/orders"},"upstream":{"status":"-","bytes":"0"},"envoy":{"flags":"UF,URX"}}`)
+	if !ok || record.UpstreamStatus != "-" || record.UpstreamLength != "0" || record.Flags != "UF,URX" {
+		t.Errorf("applog parsed %+v, %v", record, ok)
+	}
+}
+
+func TestUserLogFormatErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{
+			name:    "missing name",
+			config:  "log_formats:\n  - pattern: '(?P<status>\\d+)'\n",
+			wantErr: "without a name",
+		},
+		{
+			name:    "missing status group",
+			config:  "log_formats:\n  - name: bad\n    pattern: '(\\d+)'\n",
+			wantErr: "needs a (?P<status>...) group",
+		},
+		{
+			name:    "invalid pattern",
+			config:  "log_formats:\n  - name: bad\n    pattern: '(?P<status>'\n",
+			wantErr: "log format bad",
+		},
+		{
+			name:    "both pattern and json",
+			config:  "log_formats:\n  - name: bad\n    pattern: '(?P<status>\\d+)'\n    json: {status: code}\n",
+			wantErr: "either pattern or json",
+		},
+		{
+			name:    "invalid yaml",
+			config:  "log_formats: [",
+			wantErr: "invalid config file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writeTestConfig(t, tt.config)
+			_, err := loadUserLogFormats()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestStatsFormatFlag(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "access.log")
+	log := sampleLogFormatLines["envoy"] + "\n" + sampleLogFormatLines["envoy"] + "\n"
+	if err := os.WriteFile(path, []byte(log), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { logFormatFlag = "auto" }()
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected []string
+	}{
+		{
+			name:     "auto-detected",
+			args:     []string{"stats", path},
+			expected: []string{"Status Codes in 2 Requests", "404"},
+		},
+		{
+			name:     "explicit format",
+			args:     []string{"stats", "--format", "envoy", path},
+			expected: []string{"Status Codes in 2 Requests"},
+		},
+		{
+			name:     "mismatched format",
+			args:     []string{"stats", "--format", "caddy", path},
+			expected: []string{"No access log lines found (2 lines skipped)"},
+		},
+		{
+			name:     "unknown format",
+			args:     []string{"stats", "--format", "iis", path},
+			expected: []string{"unknown log format iis. Use auto, ingress-nginx, combined, caddy"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logFormatFlag = "auto"
+			stdout, _ := captureOutput(func() {
+				rootCmd.SetArgs(tt.args)
+				rootCmd.Execute()
+			})
+			for _, expected := range tt.expected {
+				if !strings.Contains(stdout, expected) {
+					t.Errorf("Expected '%s' in output, got: %s", expected, stdout)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+// Color used for HTTP method names
+var methodNameColor = lipgloss.Color("#1abc9c")
+
+// methodCmd represents the method command
+var methodCmd = &cobra.Command{
+	Use:   "method [name]",
+	Short: "Look up HTTP request methods",
+	Long: `Look up HTTP request methods: whether they are safe, idempotent and cacheable,
+and which status codes typically answer them.
+
+Running 'httpcode method' without arguments lists all known methods.`,
+	ValidArgs: sortedMethodNames(),
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 {
+			listMethods()
+			return
+		}
+		lookupMethod(args[0])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(methodCmd)
+}
+
+// sortedMethodNames returns the method names in alphabetical order
+func sortedMethodNames() []string {
+	var names []string
+	for name := range httpMethodsInfo {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// lookupMethod looks up a specific HTTP method by name
+func lookupMethod(name string) {
+	if info, exists := findMethod(name); exists {
+		displayMethodWithLipgloss(info)
+	} else {
+		displayErrorWithLipgloss(fmt.Sprintf("HTTP method %s not found", name))
+	}
+}
+
+func listMethods() {
+	displayListHeaderWithLipgloss("All HTTP Methods")
+	for _, name := range sortedMethodNames() {
+		info := httpMethodsInfo[name]
+		item := lipgloss.NewStyle().
+			Foreground(methodNameColor).
+			Render(fmt.Sprintf("  %-9s", info.Name))
+		fmt.Printf("%s %s\n", item, formatMethodProperties(info))
+	}
+}
+
+// checkMethodCompatibility displays whether a status code is a usual response to a method
+func checkMethodCompatibility(method string, code int) {
+	info, exists := findMethod(method)
+	if !exists {
+		displayErrorWithLipgloss(fmt.Sprintf("HTTP method %s not found", method))
+		return
+	}
+
+	label := fmt.Sprintf("%d %s", code, httpCodes[code])
+	if warnings := methodStatusWarnings(info, code); len(warnings) > 0 {
+		for _, warning := range warnings {
+			message := lipgloss.NewStyle().
+				Foreground(redirectionColor).
+				Render(fmt.Sprintf("⚠️  Unusual:     %s in response to %s. %s", label, info.Name, warning))
+			fmt.Println(message)
+		}
+		return
+	}
+
+	var message string
+	if isTypicalStatus(info, code) {
+		message = fmt.Sprintf("✅ Method:      %s is a typical response to %s", label, info.Name)
+	} else {
+		message = fmt.Sprintf("✅ Method:      %s is a valid response to %s", label, info.Name)
+	}
+	fmt.Println(lipgloss.NewStyle().Foreground(successColor).Render(message))
+}
+
+// formatMethodProperties formats the safe, idempotent and cacheable properties
+func formatMethodProperties(info HTTPMethodInfo) string {
+	mark := func(name string, set bool) string {
+		if set {
+			return "✔ " + name
+		}
+		return "✘ " + name
+	}
+	return strings.Join([]string{
+		mark("safe", info.Safe),
+		mark("idempotent", info.Idempotent),
+		mark("cacheable", info.Cacheable),
+	}, "  ")
+}
+
+// displayMethodWithLipgloss displays HTTP method information using Lipgloss styling
+func displayMethodWithLipgloss(info HTTPMethodInfo) {
+	header := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(methodNameColor).
+		Render(fmt.Sprintf("           %s", info.Name))
+	fmt.Println(header)
+
+	properties := lipgloss.NewStyle().
+		Foreground(methodNameColor).
+		Render(fmt.Sprintf("📋 Properties:  %s", formatMethodProperties(info)))
+	fmt.Println(properties)
+
+	fmt.Printf("📝 Description: %s\n", info.Description)
+	fmt.Printf("📦 Body:        request: %s, response: %s\n", info.RequestBody, info.ResponseBody)
+	fmt.Printf("✅ Success:     %s\n", formatColoredCodes(info.SuccessCodes))
+	fmt.Printf("❌ Errors:      %s\n", formatColoredCodes(info.ErrorCodes))
+	fmt.Printf("📜 Spec:        %s\n", info.Spec)
+
+	link := lipgloss.NewStyle().
+		Foreground(linkColor).
+		Render(fmt.Sprintf("🔗 Docs:        %s", info.MDNLink))
+	fmt.Println(link)
+
+	fmt.Println()
+}
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestHTTPMethodsInfoStructure(t *testing.T) {
+	for key, info := range httpMethodsInfo {
+		t.Run(fmt.Sprintf("method_%s_structure", key), func(t *testing.T) {
+			if info.Name != key {
+				t.Errorf("Method key %q does not match name %q", key, info.Name)
+			}
+
+			if info.Description == "" || info.Spec == "" || info.MDNLink == "" {
+				t.Errorf("Method %s missing Description, Spec or MDNLink", key)
+			}
+
+			if info.Safe && !info.Idempotent {
+				t.Errorf("Method %s is safe and must therefore be idempotent", key)
+			}
+
+			for _, code := range append(append([]int{}, info.SuccessCodes...), info.ErrorCodes...) {
+				if _, exists := httpCodesInfo[code]; !exists {
+					t.Errorf("Method %s references unknown status code %d", key, code)
+				}
+				if warnings := methodStatusWarnings(info, code); len(warnings) > 0 {
+					t.Errorf("Method %s lists %d as typical but warns about it: %v", key, code, warnings)
+				}
+			}
+		})
+	}
+}
+
+func TestLookupMethod(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		wantContains []string
+	}{
+		{
+			name:   "case insensitive",
+			method: "get",
+			wantContains: []string{
+				"GET",
+				"✔ safe",
+				"✔ idempotent",
+				"✔ cacheable",
+				"200 OK",
+				"RFC 9110",
+			},
+		},
+		{
+			name:   "non idempotent method",
+			method: "POST",
+			wantContains: []string{
+				"✘ safe",
+				"✘ idempotent",
+				"201 Created",
+			},
+		},
+		{
+			name:   "unknown method",
+			method: "FETCH",
+			wantContains: []string{
+				"HTTP method FETCH not found",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout, _ := captureOutput(func() {
+				lookupMethod(tt.method)
+			})
+
+			for _, want := range tt.wantContains {
+				if !strings.Contains(stdout, want) {
+					t.Errorf("Expected '%s' in output, got: %s", want, stdout)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckMethodCompatibility(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		code         int
+		wantContains []string
+	}{
+		{
+			name:         "created for GET",
+			method:       "GET",
+			code:         201,
+			wantContains: []string{"Unusual", "201 Created in response to GET", "safe method"},
+		},
+		{
+			name:         "no content for HEAD",
+			method:       "HEAD",
+			code:         204,
+			wantContains: []string{"Unusual", "204 No Content in response to HEAD"},
+		},
+		{
+			name:         "partial content for POST",
+			method:       "post",
+			code:         206,
+			wantContains: []string{"Unusual", "Range requests are only defined for GET"},
+		},
+		{
+			name:         "not implemented for GET",
+			method:       "GET",
+			code:         501,
+			wantContains: []string{"Unusual", "must not answer it with 501"},
+		},
+		{
+			name:         "created for POST",
+			method:       "POST",
+			code:         201,
+			wantContains: []string{"typical response to POST"},
+		},
+		{
+			name:         "server error for DELETE",
+			method:       "DELETE",
+			code:         500,
+			wantContains: []string{"valid response to DELETE"},
+		},
+		{
+			name:         "unknown method",
+			method:       "BREW",
+			code:         418,
+			wantContains: []string{"HTTP method BREW not found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout, _ := captureOutput(func() {
+				checkMethodCompatibility(tt.method, tt.code)
+			})
+
+			for _, want := range tt.wantContains {
+				if !strings.Contains(stdout, want) {
+					t.Errorf("Expected '%s' in output, got: %s", want, stdout)
+				}
+			}
+		})
+	}
+}
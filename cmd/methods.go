@@ -0,0 +1,260 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+)
+
+// HTTPMethodInfo contains reference information about an HTTP request method
+type HTTPMethodInfo struct {
+	Name         string
+	Description  string
+	Safe         bool
+	Idempotent   bool
+	Cacheable    bool
+	RequestBody  string
+	ResponseBody string
+	Spec         string
+	MDNLink      string
+	SuccessCodes []int
+	ErrorCodes   []int
+}
+
+// HTTP request methods, keyed by upper-case method name
+var httpMethodsInfo = map[string]HTTPMethodInfo{
+	"GET": {
+		Name:         "GET",
+		Description:  "Requests a representation of the target resource. Requests using GET should only retrieve data.",
+		Safe:         true,
+		Idempotent:   true,
+		Cacheable:    true,
+		RequestBody:  "No defined semantics",
+		ResponseBody: "Yes",
+		Spec:         "RFC 9110, Section 9.3.1",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Methods/GET",
+		SuccessCodes: []int{200, 206, 304},
+		ErrorCodes:   []int{400, 401, 403, 404, 406, 410, 416},
+	},
+	"HEAD": {
+		Name:         "HEAD",
+		Description:  "Identical to GET except that the server must not send content in the response; used to read metadata.",
+		Safe:         true,
+		Idempotent:   true,
+		Cacheable:    true,
+		RequestBody:  "No defined semantics",
+		ResponseBody: "No",
+		Spec:         "RFC 9110, Section 9.3.2",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Methods/HEAD",
+		SuccessCodes: []int{200, 304},
+		ErrorCodes:   []int{400, 401, 403, 404, 410},
+	},
+	"POST": {
+		Name:         "POST",
+		Description:  "Requests that the target resource process the enclosed representation according to its own semantics, often creating a subordinate resource.",
+		Safe:         false,
+		Idempotent:   false,
+		Cacheable:    false,
+		RequestBody:  "Yes",
+		ResponseBody: "Yes",
+		Spec:         "RFC 9110, Section 9.3.3",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Methods/POST",
+		SuccessCodes: []int{200, 201, 202, 204, 303},
+		ErrorCodes:   []int{400, 401, 403, 404, 409, 413, 415, 422, 429},
+	},
+	"PUT": {
+		Name:         "PUT",
+		Description:  "Creates or replaces the state of the target resource with the state defined by the enclosed representation.",
+		Safe:         false,
+		Idempotent:   true,
+		Cacheable:    false,
+		RequestBody:  "Yes",
+		ResponseBody: "May",
+		Spec:         "RFC 9110, Section 9.3.4",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Methods/PUT",
+		SuccessCodes: []int{200, 201, 204},
+		ErrorCodes:   []int{400, 401, 403, 404, 409, 412, 413, 415, 422, 428},
+	},
+	"PATCH": {
+		Name:         "PATCH",
+		Description:  "Applies partial modifications to the target resource using a patch document.",
+		Safe:         false,
+		Idempotent:   false,
+		Cacheable:    false,
+		RequestBody:  "Yes",
+		ResponseBody: "May",
+		Spec:         "RFC 5789, Section 2",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Methods/PATCH",
+		SuccessCodes: []int{200, 204},
+		ErrorCodes:   []int{400, 401, 403, 404, 409, 412, 415, 422, 428},
+	},
+	"DELETE": {
+		Name:         "DELETE",
+		Description:  "Requests that the origin server remove the association between the target resource and its current functionality.",
+		Safe:         false,
+		Idempotent:   true,
+		Cacheable:    false,
+		RequestBody:  "No defined semantics",
+		ResponseBody: "May",
+		Spec:         "RFC 9110, Section 9.3.5",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Methods/DELETE",
+		SuccessCodes: []int{200, 202, 204},
+		ErrorCodes:   []int{401, 403, 404, 409, 410, 412},
+	},
+	"OPTIONS": {
+		Name:         "OPTIONS",
+		Description:  "Requests the communication options available for the target resource; also used for CORS preflight requests.",
+		Safe:         true,
+		Idempotent:   true,
+		Cacheable:    false,
+		RequestBody:  "May",
+		ResponseBody: "May",
+		Spec:         "RFC 9110, Section 9.3.7",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Methods/OPTIONS",
+		SuccessCodes: []int{200, 204},
+		ErrorCodes:   []int{403, 404, 405},
+	},
+	"TRACE": {
+		Name:         "TRACE",
+		Description:  "Performs a message loop-back test along the path to the target resource. Usually disabled for security reasons.",
+		Safe:         true,
+		Idempotent:   true,
+		Cacheable:    false,
+		RequestBody:  "No",
+		ResponseBody: "Yes (message/http)",
+		Spec:         "RFC 9110, Section 9.3.8",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Methods/TRACE",
+		SuccessCodes: []int{200},
+		ErrorCodes:   []int{405, 501},
+	},
+	"CONNECT": {
+		Name:         "CONNECT",
+		Description:  "Establishes a tunnel to the server identified by the target resource, typically through a proxy for TLS.",
+		Safe:         false,
+		Idempotent:   false,
+		Cacheable:    false,
+		RequestBody:  "No defined semantics",
+		ResponseBody: "No (2xx switches to tunnel mode)",
+		Spec:         "RFC 9110, Section 9.3.6",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Web/HTTP/Methods/CONNECT",
+		SuccessCodes: []int{200},
+		ErrorCodes:   []int{403, 405, 407, 502, 504},
+	},
+	"PROPFIND": {
+		Name:         "PROPFIND",
+		Description:  "Retrieves properties defined on the resource and, for collections, on its members (WebDAV).",
+		Safe:         true,
+		Idempotent:   true,
+		Cacheable:    false,
+		RequestBody:  "May (XML)",
+		ResponseBody: "Yes (multistatus XML)",
+		Spec:         "RFC 4918, Section 9.1",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Glossary/WebDAV",
+		SuccessCodes: []int{207},
+		ErrorCodes:   []int{403, 404},
+	},
+	"MKCOL": {
+		Name:         "MKCOL",
+		Description:  "Creates a new collection resource at the location specified by the request URI (WebDAV).",
+		Safe:         false,
+		Idempotent:   false,
+		Cacheable:    false,
+		RequestBody:  "May",
+		ResponseBody: "May",
+		Spec:         "RFC 4918, Section 9.3",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Glossary/WebDAV",
+		SuccessCodes: []int{201},
+		ErrorCodes:   []int{403, 405, 409, 415, 507},
+	},
+	"LOCK": {
+		Name:         "LOCK",
+		Description:  "Takes out a lock of any access type on the resource, refreshing an existing lock if one is given (WebDAV).",
+		Safe:         false,
+		Idempotent:   false,
+		Cacheable:    false,
+		RequestBody:  "Yes (XML)",
+		ResponseBody: "Yes (XML)",
+		Spec:         "RFC 4918, Section 9.10",
+		MDNLink:      "https://developer.mozilla.org/en-US/docs/Glossary/WebDAV",
+		SuccessCodes: []int{200, 201},
+		ErrorCodes:   []int{409, 412, 423},
+	},
+}
+
+// Methods a general-purpose server is required to support
+var requiredMethods = map[string]bool{"GET": true, "HEAD": true}
+
+// findMethod looks up a request method by name, ignoring case
+func findMethod(name string) (HTTPMethodInfo, bool) {
+	info, exists := httpMethodsInfo[strings.ToUpper(strings.TrimSpace(name))]
+	return info, exists
+}
+
+// isTypicalStatus reports whether the code is listed as a typical response to the method
+func isTypicalStatus(info HTTPMethodInfo, code int) bool {
+	for _, c := range info.SuccessCodes {
+		if c == code {
+			return true
+		}
+	}
+	for _, c := range info.ErrorCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// methodStatusWarnings explains why a status code is an unusual response to a method
+func methodStatusWarnings(info HTTPMethodInfo, code int) []string {
+	var warnings []string
+	name := info.Name
+	expectsBody := strings.HasPrefix(info.RequestBody, "Yes")
+	allowsBody := expectsBody || strings.HasPrefix(info.RequestBody, "May")
+
+	switch code {
+	case 100:
+		if !expectsBody {
+			warnings = append(warnings, fmt.Sprintf("100 Continue only makes sense when a request body follows; %s requests normally have none.", name))
+		}
+	case 201:
+		if info.Safe {
+			warnings = append(warnings, fmt.Sprintf("%s is a safe method and should not create resources.", name))
+		} else if name == "DELETE" {
+			warnings = append(warnings, "DELETE removes resources; 201 Created contradicts its semantics.")
+		}
+	case 204, 205:
+		if name == "GET" {
+			warnings = append(warnings, fmt.Sprintf("%d for %s means the resource has an empty representation; a 200 with a body (or 404) is usually intended.", code, name))
+		}
+	case 206:
+		if name != "GET" {
+			warnings = append(warnings, "Range requests are only defined for GET; 206 Partial Content should not answer "+name+".")
+		}
+	case 304:
+		if name != "GET" && name != "HEAD" {
+			warnings = append(warnings, "304 Not Modified only answers conditional GET or HEAD requests.")
+		}
+	case 303:
+		if name == "GET" || name == "HEAD" {
+			warnings = append(warnings, "303 See Other is meant to redirect the result of a non-safe request; for "+name+" prefer 302, 307 or 308.")
+		}
+	case 411, 413, 415:
+		if !allowsBody {
+			warnings = append(warnings, fmt.Sprintf("%d is about the request body, but %s requests normally have none.", code, name))
+		}
+	case 428:
+		if info.Safe {
+			warnings = append(warnings, "428 Precondition Required protects state-changing requests; "+name+" is a safe method.")
+		}
+	case 501:
+		if requiredMethods[name] {
+			warnings = append(warnings, fmt.Sprintf("General-purpose servers are required to support %s, so they must not answer it with 501.", name))
+		}
+	}
+
+	if len(warnings) == 0 && name == "HEAD" && code > 200 && code < 300 {
+		warnings = append(warnings, "A HEAD response should carry the same status a GET would, without content.")
+	}
+
+	return warnings
+}
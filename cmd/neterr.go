@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+// Colors used for client-side network error kinds
+var netErrKindColors = map[string]lipgloss.Color{
+	netErrKindConnection: clientErrorColor,
+	netErrKindDNS:        redirectionColor,
+	netErrKindTLS:        serverErrorColor,
+	netErrKindResponse:   globalFailureColor,
+}
+
+// Order of kinds in the network error list
+var netErrKindOrder = []string{netErrKindConnection, netErrKindDNS, netErrKindTLS, netErrKindResponse}
+
+// neterrCmd represents the neterr command
+var neterrCmd = &cobra.Command{
+	Use:   "neterr [name|number|message]",
+	Short: "Explain client-side network errors",
+	Long: `Explain client-side failures that happen instead of an HTTP status code:
+socket errors such as ECONNREFUSED, ECONNRESET and ETIMEDOUT, DNS and TLS
+failures, and curl exit codes (6, 7, 28, 35, 52, 56, ...).
+
+The argument can be an error name, a curl exit code or a pasted error message,
+e.g. "dial tcp 10.0.0.1:443: connect: connection refused".
+
+Running 'httpcode neterr' without arguments lists all known errors.`,
+	Args: cobra.ArbitraryArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 {
+			listNetErrors()
+			return
+		}
+		lookupNetError(strings.Join(args, " "))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(neterrCmd)
+}
+
+// lookupNetError looks up a client-side network error
+func lookupNetError(arg string) {
+	if info, exists := findNetError(arg); exists {
+		displayNetErrorWithLipgloss(info)
+	} else {
+		displayErrorWithLipgloss(fmt.Sprintf("Network error %s not found", arg))
+	}
+}
+
+func listNetErrors() {
+	displayListHeaderWithLipgloss("Client-Side Network Errors")
+	for _, kind := range netErrKindOrder {
+		title := lipgloss.NewStyle().
+			Bold(true).
+			Foreground(netErrKindColors[kind]).
+			Render(kind)
+		fmt.Println(title)
+
+		for _, info := range netErrorsInfo {
+			if info.Kind != kind {
+				continue
+			}
+			name := lipgloss.NewStyle().
+				Foreground(netErrKindColors[kind]).
+				Render(fmt.Sprintf("  %-22s", info.Name))
+			curl := lipgloss.NewStyle().
+				Foreground(mutedColor).
+				Render(fmt.Sprintf("curl %d", info.CurlExit))
+			fmt.Printf("%s %-32s %s\n", name, info.Message, curl)
+		}
+	}
+}
+
+// formatErrno formats the errno values of a network error per platform
+func formatErrno(info NetErrorInfo) string {
+	if info.LinuxErrno == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d (Linux), %d (macOS)", info.LinuxErrno, info.DarwinErrno)
+}
+
+// displayNetErrorWithLipgloss displays a client-side network error using Lipgloss styling
+func displayNetErrorWithLipgloss(info NetErrorInfo) {
+	color := netErrKindColors[info.Kind]
+
+	header := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(color).
+		Render(fmt.Sprintf("           %s %s", info.Name, info.Message))
+	fmt.Println(header)
+
+	kind := lipgloss.NewStyle().
+		Foreground(color).
+		Render(fmt.Sprintf("📋 Kind:        %s", info.Kind))
+	fmt.Println(kind)
+
+	fmt.Printf("📝 Description: %s\n", info.Description)
+
+	if errno := formatErrno(info); errno != "" {
+		fmt.Printf("🔢 errno:       %s\n", errno)
+	}
+	curl := fmt.Sprintf("exit %d (%s)", info.CurlExit, info.CurlName)
+	if others := netErrorsWithCurlExit(info); len(others) > 0 {
+		curl += fmt.Sprintf(", also reported for %s", strings.Join(others, ", "))
+	}
+	fmt.Printf("🌀 curl:        %s\n", curl)
+
+	for i, cause := range info.Causes {
+		if i == 0 {
+			fmt.Printf("🔍 Causes:      - %s\n", cause)
+		} else {
+			fmt.Printf("                - %s\n", cause)
+		}
+	}
+
+	// A reverse proxy turns the upstream failure into an HTTP status for its client
+	proxy := lipgloss.NewStyle().
+		Foreground(getStatusCodeColor(info.ProxyStatus)).
+		Render(formatRelatedCodes([]int{info.ProxyStatus}))
+	if info.ProxyNote != "" {
+		proxy += fmt.Sprintf(" (%s)", info.ProxyNote)
+	}
+	fmt.Printf("🌐 Via proxy:   %s\n", proxy)
+
+	link := lipgloss.NewStyle().
+		Foreground(linkColor).
+		Render(fmt.Sprintf("🔗 Docs:        %s", info.Link))
+	fmt.Println(link)
+
+	fmt.Println()
+}
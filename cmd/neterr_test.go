@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNetErrorsInfoStructure(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, info := range netErrorsInfo {
+		t.Run(info.Name, func(t *testing.T) {
+			if seen[info.Name] {
+				t.Errorf("Network error %s is defined more than once", info.Name)
+			}
+			seen[info.Name] = true
+
+			if info.Message == "" || info.Description == "" || len(info.Causes) == 0 || info.Link == "" {
+				t.Errorf("Network error %s missing Message, Description, Causes or Link", info.Name)
+			}
+
+			if _, exists := netErrKindColors[info.Kind]; !exists {
+				t.Errorf("Network error %s has unknown kind %q", info.Name, info.Kind)
+			}
+
+			if _, exists := httpCodesInfo[info.ProxyStatus]; !exists {
+				t.Errorf("Network error %s maps to unknown status code %d", info.Name, info.ProxyStatus)
+			}
+		})
+	}
+
+	// curl exit codes named in the request must resolve
+	for _, exit := range []string{"6", "7", "28", "35", "52", "56"} {
+		if _, exists := findNetError(exit); !exists {
+			t.Errorf("curl exit code %s not found", exit)
+		}
+	}
+}
+
+func TestFindNetError(t *testing.T) {
+	tests := []struct {
+		arg      string
+		wantName string
+		wantOK   bool
+	}{
+		{"ECONNREFUSED", "ECONNREFUSED", true},
+		{"econnreset", "ECONNRESET", true},
+		{"CURLE_OPERATION_TIMEDOUT", "ETIMEDOUT", true},
+		{"7", "ECONNREFUSED", true},
+		{"35", "TLS_HANDSHAKE_FAILURE", true},
+		{"52", "EMPTY_REPLY", true},
+		{"connect ECONNREFUSED 127.0.0.1:3000", "ECONNREFUSED", true},
+		{"read tcp 10.0.0.2:51234->10.0.0.1:443: read: connection reset by peer", "ECONNRESET", true},
+		{"dial tcp: lookup api.internal: no such host", "ENOTFOUND", true},
+		{"tls: failed to verify certificate: x509: certificate has expired", "CERT_VERIFY_FAILED", true},
+		{"remote error: tls: handshake failure", "TLS_HANDSHAKE_FAILURE", true},
+		{"curl: (35) OpenSSL SSL_connect: SSL_ERROR_SYSCALL in connection to example.com:443", "TLS_HANDSHAKE_FAILURE", true},
+		{"curl: (52) Empty reply from server", "EMPTY_REPLY", true},
+		{"curl: (56) Recv failure: Connection reset by peer", "ECONNRESET", true},
+		{"curl: (99) something new", "", false},
+		{"99", "", false},
+		{"everything is fine", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			info, ok := findNetError(tt.arg)
+			if ok != tt.wantOK {
+				t.Fatalf("findNetError(%q) found = %v, expected %v", tt.arg, ok, tt.wantOK)
+			}
+			if ok && info.Name != tt.wantName {
+				t.Errorf("findNetError(%q) = %s, expected %s", tt.arg, info.Name, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestLookupNetError(t *testing.T) {
+	tests := []struct {
+		name         string
+		arg          string
+		wantContains []string
+	}{
+		{
+			name: "timeout surfaces as 504",
+			arg:  "ETIMEDOUT",
+			wantContains: []string{
+				"ETIMEDOUT Connection timed out",
+				"110 (Linux)",
+				"exit 28",
+				"504 Gateway Timeout",
+			},
+		},
+		{
+			name: "shared curl exit code",
+			arg:  "7",
+			wantContains: []string{
+				"ECONNREFUSED",
+				"also reported for EHOSTUNREACH, ENETUNREACH",
+				"502 Bad Gateway",
+			},
+		},
+		{
+			name: "not found",
+			arg:  "EWHATEVER",
+			wantContains: []string{
+				"Network error EWHATEVER not found",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout, _ := captureOutput(func() {
+				lookupNetError(tt.arg)
+			})
+
+			for _, want := range tt.wantContains {
+				if !strings.Contains(stdout, want) {
+					t.Errorf("Expected '%s' in output, got: %s", want, stdout)
+				}
+			}
+		})
+	}
+}
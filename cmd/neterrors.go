@@ -0,0 +1,311 @@
+package cmd
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Kinds of client-side network errors
+const (
+	netErrKindConnection = "Connection"
+	netErrKindDNS        = "DNS"
+	netErrKindTLS        = "TLS"
+	netErrKindResponse   = "Response"
+)
+
+// Documentation for errno values and curl exit codes
+const (
+	errnoDocsLink    = "https://man7.org/linux/man-pages/man3/errno.3.html"
+	curlErrorsLink   = "https://curl.se/libcurl/c/libcurl-errors.html"
+	tlsHandshakeLink = "https://www.rfc-editor.org/rfc/rfc8446#section-6.2"
+)
+
+// NetErrorInfo contains information about a client-side network failure that
+// happens before (or instead of) an HTTP status code
+type NetErrorInfo struct {
+	Name        string
+	Message     string
+	Kind        string
+	LinuxErrno  int
+	DarwinErrno int
+	CurlExit    int
+	CurlName    string
+	Description string
+	Causes      []string
+	// Status a reverse proxy typically returns to its client for this upstream failure
+	ProxyStatus int
+	ProxyNote   string
+	// Substrings of error messages that identify this failure
+	Patterns []string
+	Link     string
+}
+
+// Client-side network errors. Entries sharing a curl exit code are ordered
+// with the most common cause first, and more specific message patterns come
+// before general ones.
+var netErrorsInfo = []NetErrorInfo{
+	{
+		Name:        "ECONNREFUSED",
+		Message:     "Connection refused",
+		Kind:        netErrKindConnection,
+		LinuxErrno:  111,
+		DarwinErrno: 61,
+		CurlExit:    7,
+		CurlName:    "CURLE_COULDNT_CONNECT",
+		Description: "The host answered the TCP SYN with a RST: it is reachable, but nothing accepts connections on that port.",
+		Causes: []string{
+			"The service is not running, crashed or is still starting",
+			"Wrong port, or the service listens on 127.0.0.1 only",
+			"A firewall rejects (rather than drops) the connection",
+		},
+		ProxyStatus: 502,
+		ProxyNote:   "Envoy: 503 with response flag UF",
+		Patterns:    []string{"connection refused", "couldn't connect to server"},
+		Link:        errnoDocsLink,
+	},
+	{
+		Name:        "ECONNRESET",
+		Message:     "Connection reset by peer",
+		Kind:        netErrKindConnection,
+		LinuxErrno:  104,
+		DarwinErrno: 54,
+		CurlExit:    56,
+		CurlName:    "CURLE_RECV_ERROR",
+		Description: "The peer aborted an established connection with a RST while data was still expected.",
+		Causes: []string{
+			"The server process crashed or was killed mid-request",
+			"A keep-alive connection was reused just as the server closed it",
+			"A load balancer, NAT or firewall dropped the idle connection",
+		},
+		ProxyStatus: 502,
+		ProxyNote:   "Envoy: 503 with response flag UC or UR",
+		Patterns:    []string{"connection reset", "socket hang up", "recv failure"},
+		Link:        errnoDocsLink,
+	},
+	{
+		Name:        "ETIMEDOUT",
+		Message:     "Connection timed out",
+		Kind:        netErrKindConnection,
+		LinuxErrno:  110,
+		DarwinErrno: 60,
+		CurlExit:    28,
+		CurlName:    "CURLE_OPERATION_TIMEDOUT",
+		Description: "No answer arrived in time, either to the connection attempt or while waiting for the response.",
+		Causes: []string{
+			"A firewall or security group silently drops the packets",
+			"The host is down or the address is wrong",
+			"The server is overloaded or the request is slower than the client timeout",
+		},
+		ProxyStatus: 504,
+		ProxyNote:   "Envoy: 504 with response flag UT",
+		Patterns:    []string{"timed out", "i/o timeout", "deadline exceeded"},
+		Link:        errnoDocsLink,
+	},
+	{
+		Name:        "EHOSTUNREACH",
+		Message:     "No route to host",
+		Kind:        netErrKindConnection,
+		LinuxErrno:  113,
+		DarwinErrno: 65,
+		CurlExit:    7,
+		CurlName:    "CURLE_COULDNT_CONNECT",
+		Description: "The host could not be reached, typically because an ICMP host unreachable came back.",
+		Causes: []string{
+			"The host is down on the local network",
+			"A firewall rejects the traffic with ICMP host unreachable",
+		},
+		ProxyStatus: 502,
+		ProxyNote:   "Envoy: 503 with response flag UF",
+		Patterns:    []string{"no route to host"},
+		Link:        errnoDocsLink,
+	},
+	{
+		Name:        "ENETUNREACH",
+		Message:     "Network is unreachable",
+		Kind:        netErrKindConnection,
+		LinuxErrno:  101,
+		DarwinErrno: 51,
+		CurlExit:    7,
+		CurlName:    "CURLE_COULDNT_CONNECT",
+		Description: "The local host has no route to the destination network.",
+		Causes: []string{
+			"Connecting over IPv6 without IPv6 connectivity",
+			"A VPN or network interface is down",
+		},
+		ProxyStatus: 502,
+		ProxyNote:   "Envoy: 503 with response flag UF",
+		Patterns:    []string{"network is unreachable"},
+		Link:        errnoDocsLink,
+	},
+	{
+		Name:        "EPIPE",
+		Message:     "Broken pipe",
+		Kind:        netErrKindConnection,
+		LinuxErrno:  32,
+		DarwinErrno: 32,
+		CurlExit:    55,
+		CurlName:    "CURLE_SEND_ERROR",
+		Description: "The client wrote to a connection the peer has already closed.",
+		Causes: []string{
+			"The server rejected a large upload early and closed the connection",
+			"The server closed an idle keep-alive connection",
+		},
+		ProxyStatus: 502,
+		Patterns:    []string{"broken pipe", "send failure"},
+		Link:        errnoDocsLink,
+	},
+	{
+		Name:        "ENOTFOUND",
+		Message:     "Name or service not known",
+		Kind:        netErrKindDNS,
+		CurlExit:    6,
+		CurlName:    "CURLE_COULDNT_RESOLVE_HOST",
+		Description: "DNS resolution of the host name failed: the name does not exist (EAI_NONAME).",
+		Causes: []string{
+			"A typo in the host name",
+			"The DNS record is missing or not yet propagated",
+			"An internal name resolved from outside its network or container",
+		},
+		ProxyStatus: 502,
+		ProxyNote:   "Envoy: 503 with response flag DF",
+		Patterns:    []string{"no such host", "name or service not known", "could not resolve host", "nodename nor servname", "eai_noname"},
+		Link:        curlErrorsLink,
+	},
+	{
+		Name:        "EAI_AGAIN",
+		Message:     "Temporary failure in name resolution",
+		Kind:        netErrKindDNS,
+		CurlExit:    6,
+		CurlName:    "CURLE_COULDNT_RESOLVE_HOST",
+		Description: "The DNS resolver could not answer in time; the name may well exist.",
+		Causes: []string{
+			"The resolver is unreachable or overloaded",
+			"No network connectivity, e.g. at container start-up",
+		},
+		ProxyStatus: 502,
+		ProxyNote:   "Envoy: 503 with response flag DF",
+		Patterns:    []string{"temporary failure in name resolution"},
+		Link:        curlErrorsLink,
+	},
+	{
+		Name:        "CERT_VERIFY_FAILED",
+		Message:     "Certificate verification failed",
+		Kind:        netErrKindTLS,
+		CurlExit:    60,
+		CurlName:    "CURLE_PEER_FAILED_VERIFICATION",
+		Description: "The server's certificate chain could not be verified, so the client aborted the handshake.",
+		Causes: []string{
+			"Self-signed certificate or private CA not in the trust store",
+			"Expired certificate",
+			"The host name does not match the certificate",
+			"The server does not send its intermediate certificates",
+		},
+		ProxyStatus: 502,
+		Patterns:    []string{"certificate verify failed", "x509:", "unable to verify the first certificate", "cert_has_expired", "ssl certificate problem"},
+		Link:        curlErrorsLink,
+	},
+	{
+		Name:        "TLS_HANDSHAKE_FAILURE",
+		Message:     "TLS handshake failed",
+		Kind:        netErrKindTLS,
+		CurlExit:    35,
+		CurlName:    "CURLE_SSL_CONNECT_ERROR",
+		Description: "The TCP connection succeeded but the TLS handshake did not complete.",
+		Causes: []string{
+			"No common TLS version or cipher suite",
+			"Missing or wrong SNI host name",
+			"The server requires a client certificate (mTLS)",
+			"Speaking TLS to a plain HTTP port (wrong version number)",
+		},
+		ProxyStatus: 502,
+		ProxyNote:   "Envoy: 503 with response flag UF and a TLS error",
+		Patterns:    []string{"handshake failure", "ssl connect error", "wrong version number", "eproto", "tls: "},
+		Link:        tlsHandshakeLink,
+	},
+	{
+		Name:        "EMPTY_REPLY",
+		Message:     "Empty reply from server",
+		Kind:        netErrKindResponse,
+		CurlExit:    52,
+		CurlName:    "CURLE_GOT_NOTHING",
+		Description: "The server closed the connection without sending any response bytes.",
+		Causes: []string{
+			"The server crashed while handling the request",
+			"Plain HTTP sent to a TLS port",
+			"A proxy or load balancer closed the connection",
+		},
+		ProxyStatus: 502,
+		ProxyNote:   "nginx: upstream prematurely closed connection",
+		Patterns:    []string{"empty reply from server", "unexpected eof", "got nothing"},
+		Link:        curlErrorsLink,
+	},
+}
+
+// Exit code at the start of a curl error message, e.g. "curl: (35) ..."
+var curlMessageExit = regexp.MustCompile(`^\s*curl: \((\d+)\)`)
+
+// findNetError looks up a network error by name (ECONNREFUSED), curl error
+// name (CURLE_COULDNT_CONNECT), curl exit code (7) or a pasted error message
+func findNetError(arg string) (NetErrorInfo, bool) {
+	if number, err := strconv.Atoi(arg); err == nil {
+		return findNetErrorByCurlExit(number)
+	}
+
+	upper := strings.ToUpper(arg)
+	for _, info := range netErrorsInfo {
+		if info.Name == upper || info.CurlName == upper {
+			return info, true
+		}
+	}
+
+	// Names inside messages such as "connect ECONNREFUSED 127.0.0.1:443"
+	for _, field := range strings.FieldsFunc(upper, func(r rune) bool {
+		return !(r >= 'A' && r <= 'Z' || r == '_')
+	}) {
+		for _, info := range netErrorsInfo {
+			if info.Name == field || info.CurlName == field {
+				return info, true
+			}
+		}
+	}
+
+	lower := strings.ToLower(arg)
+	for _, info := range netErrorsInfo {
+		for _, pattern := range info.Patterns {
+			if strings.Contains(lower, pattern) {
+				return info, true
+			}
+		}
+	}
+
+	// curl messages whose text names no known error, such as
+	// "curl: (35) OpenSSL SSL_connect: SSL_ERROR_SYSCALL"
+	if match := curlMessageExit.FindStringSubmatch(arg); match != nil {
+		number, _ := strconv.Atoi(match[1])
+		return findNetErrorByCurlExit(number)
+	}
+	return NetErrorInfo{}, false
+}
+
+// findNetErrorByCurlExit looks up the first network error reported with a
+// curl exit code
+func findNetErrorByCurlExit(number int) (NetErrorInfo, bool) {
+	for _, info := range netErrorsInfo {
+		if info.CurlExit == number {
+			return info, true
+		}
+	}
+	return NetErrorInfo{}, false
+}
+
+// netErrorsWithCurlExit returns the other errors reported with the same curl exit code
+func netErrorsWithCurlExit(info NetErrorInfo) []string {
+	var names []string
+	for _, other := range netErrorsInfo {
+		if other.CurlExit == info.CurlExit && other.Name != info.Name {
+			names = append(names, other.Name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,394 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+// Members defined by RFC 9457; everything else is an extension member
+var problemStandardMembers = map[string]bool{
+	"type":     true,
+	"title":    true,
+	"status":   true,
+	"detail":   true,
+	"instance": true,
+}
+
+// Recommended shape of extension member names (RFC 9457, Section 3.2)
+var problemExtensionName = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{2,}$`)
+
+// problemDocument is an RFC 9457 Problem Details object
+type problemDocument struct {
+	Type       string
+	Title      string
+	Status     int
+	Detail     string
+	Instance   string
+	Extensions map[string]interface{}
+}
+
+// problemIssue is a single finding from validating a problem document
+type problemIssue struct {
+	Error   bool
+	Message string
+}
+
+var (
+	problemDetail   string
+	problemInstance string
+	problemType     string
+	problemExts     []string
+	problemFormat   string
+	problemValidate string
+)
+
+// problemCmd represents the problem command
+var problemCmd = &cobra.Command{
+	Use:   "problem <code>",
+	Short: "Generate or validate RFC 9457 Problem Details documents",
+	Long: `Generate an application/problem+json (or +xml) document for a 4xx or 5xx
+status code. The title is taken from the status code description.
+
+Extension members are given as --ext key=value; values that are valid JSON
+(numbers, booleans, arrays, objects) are kept as such, anything else is a string.
+
+Use --validate file.json to check an existing problem document against
+RFC 9457 and, when a code is given, against the expected status.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if problemValidate != "" {
+			expected := 0
+			if len(args) > 0 {
+				code, err := parseStatusCode(args[0])
+				if err != nil {
+					displayErrorWithLipgloss(err.Error())
+					os.Exit(1)
+				}
+				expected = code
+			}
+			data, err := os.ReadFile(problemValidate)
+			if err != nil {
+				displayErrorWithLipgloss(err.Error())
+				os.Exit(1)
+			}
+			issues := validateProblemDocument(data, expected)
+			displayProblemIssuesWithLipgloss(problemValidate, issues)
+			for _, issue := range issues {
+				if issue.Error {
+					os.Exit(1)
+				}
+			}
+			return
+		}
+
+		if len(args) == 0 {
+			cmd.Help()
+			return
+		}
+		output, err := generateProblem(args[0])
+		if err != nil {
+			displayErrorWithLipgloss(err.Error())
+			os.Exit(1)
+		}
+		fmt.Print(output)
+	},
+}
+
+func init() {
+	problemCmd.Flags().StringVar(&problemDetail, "detail", "", "human-readable explanation specific to this occurrence")
+	problemCmd.Flags().StringVar(&problemInstance, "instance", "", "URI reference identifying this occurrence")
+	problemCmd.Flags().StringVar(&problemType, "type", "about:blank", "URI reference identifying the problem type")
+	problemCmd.Flags().StringArrayVar(&problemExts, "ext", nil, "extension member as key=value (repeatable)")
+	problemCmd.Flags().StringVar(&problemFormat, "format", "json", "output format: json or xml")
+	problemCmd.Flags().StringVar(&problemValidate, "validate", "", "validate an existing problem document")
+	rootCmd.AddCommand(problemCmd)
+}
+
+// newProblemDocument returns the problem document for a status code with a blank type
+func newProblemDocument(code int) problemDocument {
+	return problemDocument{
+		Type:   "about:blank",
+		Title:  httpCodesInfo[code].Description,
+		Status: code,
+	}
+}
+
+// generateProblem builds a problem document from the command flags
+func generateProblem(arg string) (string, error) {
+	code, err := parseStatusCode(arg)
+	if err != nil {
+		return "", err
+	}
+	if code < 400 {
+		return "", fmt.Errorf("problem details are only defined for 4xx and 5xx responses, not %d", code)
+	}
+
+	doc := newProblemDocument(code)
+	doc.Type = problemType
+	doc.Detail = problemDetail
+	doc.Instance = problemInstance
+	if doc.Extensions, err = parseProblemExtensions(problemExts); err != nil {
+		return "", err
+	}
+
+	switch problemFormat {
+	case "json":
+		return renderProblemJSON(doc)
+	case "xml":
+		return renderProblemXML(doc)
+	}
+	return "", fmt.Errorf("invalid format %s. Use json or xml", problemFormat)
+}
+
+// parseProblemExtensions parses key=value pairs into extension members
+func parseProblemExtensions(pairs []string) (map[string]interface{}, error) {
+	extensions := make(map[string]interface{})
+	for _, pair := range pairs {
+		key, value, found := strings.Cut(pair, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid extension %s. Use key=value", pair)
+		}
+		if problemStandardMembers[key] {
+			return nil, fmt.Errorf("extension %s would replace a standard member; use --%s instead", key, key)
+		}
+		if !problemExtensionName.MatchString(key) {
+			return nil, fmt.Errorf("extension name %s should start with a letter and contain at least three letters, digits or underscores", key)
+		}
+
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(value), &decoded); err == nil {
+			extensions[key] = decoded
+		} else {
+			extensions[key] = value
+		}
+	}
+	return extensions, nil
+}
+
+// renderProblemJSON renders a problem document with standard members first
+func renderProblemJSON(doc problemDocument) (string, error) {
+	type member struct {
+		key   string
+		value interface{}
+	}
+	members := []member{{"type", doc.Type}, {"title", doc.Title}, {"status", doc.Status}}
+	if doc.Detail != "" {
+		members = append(members, member{"detail", doc.Detail})
+	}
+	if doc.Instance != "" {
+		members = append(members, member{"instance", doc.Instance})
+	}
+	var keys []string
+	for key := range doc.Extensions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		members = append(members, member{key, doc.Extensions[key]})
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("{\n")
+	for i, m := range members {
+		value, err := json.MarshalIndent(m.value, "  ", "  ")
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&buf, "  %q: %s", m.key, value)
+		if i < len(members)-1 {
+			buf.WriteString(",")
+		}
+		buf.WriteString("\n")
+	}
+	buf.WriteString("}\n")
+	return buf.String(), nil
+}
+
+// renderProblemXML renders a problem document as application/problem+xml
+func renderProblemXML(doc problemDocument) (string, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	buf.WriteString("<problem xmlns=\"urn:ietf:rfc:7807\">\n")
+	writeProblemXMLElement(&buf, "  ", "type", doc.Type)
+	writeProblemXMLElement(&buf, "  ", "title", doc.Title)
+	writeProblemXMLElement(&buf, "  ", "status", doc.Status)
+	if doc.Detail != "" {
+		writeProblemXMLElement(&buf, "  ", "detail", doc.Detail)
+	}
+	if doc.Instance != "" {
+		writeProblemXMLElement(&buf, "  ", "instance", doc.Instance)
+	}
+	var keys []string
+	for key := range doc.Extensions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := writeProblemXMLElement(&buf, "  ", key, doc.Extensions[key]); err != nil {
+			return "", err
+		}
+	}
+	buf.WriteString("</problem>\n")
+	return buf.String(), nil
+}
+
+// Member names that can be used as XML element names as they are
+var problemXMLName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`)
+
+// writeProblemXMLElement writes a member as an XML element, mapping arrays
+// to repeated <i> elements and objects to nested elements (RFC 9457,
+// Appendix B)
+func writeProblemXMLElement(buf *bytes.Buffer, indent, name string, value interface{}) error {
+	if !problemXMLName.MatchString(name) || strings.HasPrefix(strings.ToLower(name), "xml") {
+		return fmt.Errorf("member name %q cannot be used as an XML element name", name)
+	}
+
+	var text string
+	switch v := value.(type) {
+	case []interface{}:
+		fmt.Fprintf(buf, "%s<%s>\n", indent, name)
+		for _, item := range v {
+			if err := writeProblemXMLElement(buf, indent+"  ", "i", item); err != nil {
+				return err
+			}
+		}
+		fmt.Fprintf(buf, "%s</%s>\n", indent, name)
+		return nil
+	case map[string]interface{}:
+		var keys []string
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		fmt.Fprintf(buf, "%s<%s>\n", indent, name)
+		for _, key := range keys {
+			if err := writeProblemXMLElement(buf, indent+"  ", key, v[key]); err != nil {
+				return err
+			}
+		}
+		fmt.Fprintf(buf, "%s</%s>\n", indent, name)
+		return nil
+	case string:
+		text = v
+	case nil:
+		// JSON null has no XML counterpart; leave the element empty
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		text = string(data)
+	}
+	fmt.Fprintf(buf, "%s<%s>", indent, name)
+	xml.EscapeText(buf, []byte(text))
+	fmt.Fprintf(buf, "</%s>\n", name)
+	return nil
+}
+
+// validateProblemDocument checks a JSON problem document against RFC 9457.
+// If expected is non-zero, the status member must match it.
+func validateProblemDocument(data []byte, expected int) []problemIssue {
+	var issues []problemIssue
+	fail := func(format string, args ...interface{}) {
+		issues = append(issues, problemIssue{Error: true, Message: fmt.Sprintf(format, args...)})
+	}
+	warn := func(format string, args ...interface{}) {
+		issues = append(issues, problemIssue{Message: fmt.Sprintf(format, args...)})
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var members map[string]interface{}
+	if err := decoder.Decode(&members); err != nil || members == nil {
+		fail("document is not a JSON object")
+		return issues
+	}
+
+	// Members with the wrong type must be ignored by consumers (Section 3.1)
+	for _, name := range []string{"type", "title", "detail", "instance"} {
+		if value, present := members[name]; present {
+			if _, ok := value.(string); !ok {
+				fail("%q must be a string", name)
+			}
+		}
+	}
+
+	problemType, _ := members["type"].(string)
+	if problemType == "" {
+		problemType = "about:blank"
+	}
+
+	status := 0
+	if value, present := members["status"]; !present {
+		warn("%q is missing; it should repeat the HTTP status code of the response", "status")
+	} else if number, ok := value.(json.Number); !ok {
+		fail("%q must be a number", "status")
+	} else if n, err := number.Int64(); err != nil || n < 100 || n > 599 {
+		fail("%q must be an HTTP status code between 100 and 599, got %s", "status", number)
+	} else {
+		status = int(n)
+		if _, exists := httpCodesInfo[status]; !exists {
+			warn("status %d is not a registered HTTP status code", status)
+		}
+		if status < 400 {
+			warn("status %d is not an error; problem details describe 4xx and 5xx responses", status)
+		}
+	}
+
+	if expected != 0 && status != 0 && status != expected {
+		fail("status %d does not match the declared response status %d", status, expected)
+	}
+
+	if _, present := members["title"]; !present {
+		warn("%q is missing; it should summarize the problem type", "title")
+	} else if title, ok := members["title"].(string); ok && problemType == "about:blank" && status != 0 {
+		if info, exists := httpCodesInfo[status]; exists && title != info.Description {
+			warn("with type about:blank the title should be the status phrase %q, got %q", info.Description, title)
+		}
+	}
+
+	var names []string
+	for name := range members {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !problemStandardMembers[name] && !problemExtensionName.MatchString(name) {
+			warn("extension member %q should start with a letter and contain at least three letters, digits or underscores", name)
+		}
+	}
+
+	return issues
+}
+
+// displayProblemIssuesWithLipgloss displays the result of validating a problem document
+func displayProblemIssuesWithLipgloss(source string, issues []problemIssue) {
+	errors := 0
+	for _, issue := range issues {
+		if issue.Error {
+			errors++
+			displayErrorWithLipgloss(issue.Message)
+		} else {
+			warning := lipgloss.NewStyle().
+				Foreground(redirectionColor).
+				Render(fmt.Sprintf("⚠️  %s", issue.Message))
+			fmt.Println(warning)
+		}
+	}
+
+	if errors == 0 {
+		valid := lipgloss.NewStyle().
+			Foreground(successColor).
+			Render(fmt.Sprintf("✅ %s is a valid problem document", source))
+		fmt.Println(valid)
+	}
+}
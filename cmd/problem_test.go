@@ -0,0 +1,192 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestGenerateProblem(t *testing.T) {
+	tests := []struct {
+		name         string
+		code         string
+		detail       string
+		instance     string
+		exts         []string
+		format       string
+		wantContains []string
+		wantErr      bool
+	}{
+		{
+			name:   "json with members",
+			code:   "429",
+			detail: "You sent 120 requests in 60s",
+			exts:   []string{"retry_after=30", "reason=quota"},
+			format: "json",
+			wantContains: []string{
+				`"type": "about:blank"`,
+				`"title": "Too Many Requests"`,
+				`"status": 429`,
+				`"detail": "You sent 120 requests in 60s"`,
+				`"retry_after": 30`,
+				`"reason": "quota"`,
+			},
+		},
+		{
+			name:     "xml with escaping",
+			code:     "404",
+			instance: "/items?a=1&b=2",
+			format:   "xml",
+			wantContains: []string{
+				`<problem xmlns="urn:ietf:rfc:7807">`,
+				"<title>Not Found</title>",
+				"<status>404</status>",
+				"<instance>/items?a=1&amp;b=2</instance>",
+			},
+		},
+		{
+			name:   "xml with array and object extensions",
+			code:   "400",
+			exts:   []string{`errors=[{"detail":"must be positive","pointer":"#/age"}]`, `limits={"max":10}`},
+			format: "xml",
+			wantContains: []string{
+				"  <errors>\n    <i>\n      <detail>must be positive</detail>\n      <pointer>#/age</pointer>\n    </i>\n  </errors>",
+				"  <limits>\n    <max>10</max>\n  </limits>",
+			},
+		},
+		{
+			name:    "xml with a key that is not an element name",
+			code:    "400",
+			exts:    []string{`limits={"per minute":10}`},
+			format:  "xml",
+			wantErr: true,
+		},
+		{
+			name:    "success code",
+			code:    "200",
+			format:  "json",
+			wantErr: true,
+		},
+		{
+			name:    "reserved extension name",
+			code:    "400",
+			exts:    []string{"status=500"},
+			format:  "json",
+			wantErr: true,
+		},
+		{
+			name:    "invalid format",
+			code:    "400",
+			format:  "yaml",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problemType = "about:blank"
+			problemDetail = tt.detail
+			problemInstance = tt.instance
+			problemExts = tt.exts
+			problemFormat = tt.format
+
+			output, err := generateProblem(tt.code)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error, got: %s", output)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			for _, want := range tt.wantContains {
+				if !strings.Contains(output, want) {
+					t.Errorf("Expected '%s' in output, got: %s", want, output)
+				}
+			}
+
+			if tt.format == "json" && !json.Valid([]byte(output)) {
+				t.Errorf("Output is not valid JSON: %s", output)
+			}
+		})
+	}
+}
+
+func TestValidateProblemDocument(t *testing.T) {
+	tests := []struct {
+		name       string
+		document   string
+		expected   int
+		wantErrors int
+		wantIssue  string
+	}{
+		{
+			name:     "valid document",
+			document: `{"type":"about:blank","title":"Not Found","status":404}`,
+			expected: 404,
+		},
+		{
+			name:       "not an object",
+			document:   `[1, 2]`,
+			wantErrors: 1,
+			wantIssue:  "not a JSON object",
+		},
+		{
+			name:       "status mismatch",
+			document:   `{"title":"Not Found","status":404}`,
+			expected:   410,
+			wantErrors: 1,
+			wantIssue:  "does not match",
+		},
+		{
+			name:       "status as string",
+			document:   `{"title":"Not Found","status":"404"}`,
+			wantErrors: 1,
+			wantIssue:  "must be a number",
+		},
+		{
+			name:      "title differs from status phrase",
+			document:  `{"type":"about:blank","title":"Oops","status":404}`,
+			wantIssue: "should be the status phrase",
+		},
+		{
+			name:      "custom type allows custom title",
+			document:  `{"type":"https://example.com/out-of-credit","title":"Out of credit","status":403,"balance":30}`,
+			wantIssue: "",
+		},
+		{
+			name:      "short extension name",
+			document:  `{"title":"Forbidden","status":403,"id":1}`,
+			wantIssue: `extension member "id"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := validateProblemDocument([]byte(tt.document), tt.expected)
+
+			errors := 0
+			var messages []string
+			for _, issue := range issues {
+				if issue.Error {
+					errors++
+				}
+				messages = append(messages, issue.Message)
+			}
+
+			if errors != tt.wantErrors {
+				t.Errorf("Expected %d errors, got %d: %v", tt.wantErrors, errors, messages)
+			}
+
+			joined := strings.Join(messages, "\n")
+			if tt.wantIssue == "" && len(issues) > 0 {
+				t.Errorf("Expected no issues, got: %v", messages)
+			}
+			if !strings.Contains(joined, tt.wantIssue) {
+				t.Errorf("Expected issue containing '%s', got: %v", tt.wantIssue, messages)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Protocol namespace for lookup, list and search
+var protoFlag string
+
+// protoFlagUsage is the help text of the --proto flag
+const protoFlagUsage = "protocol namespace: http, ftp, smtp, sip or rtsp"
+
+// selectedReplyProtocol returns the protocol chosen with --proto. ok is false
+// for the default http namespace; an invalid protocol is reported and valid
+// is false.
+func selectedReplyProtocol() (protocol ReplyProtocol, ok bool, valid bool) {
+	if protoFlag == "" || strings.EqualFold(protoFlag, "http") {
+		return ReplyProtocol{}, false, true
+	}
+	if protocol, exists := findReplyProtocol(protoFlag); exists {
+		return protocol, true, true
+	}
+	displayErrorWithLipgloss(fmt.Sprintf("Invalid protocol %s. Use http, %s.", protoFlag, strings.Join(replyProtocolKeys(), ", ")))
+	return ReplyProtocol{}, false, false
+}
+
+// lookupProtocolCode looks up a reply code of a non-HTTP protocol
+func lookupProtocolCode(protocol ReplyProtocol, code int) {
+	if info, exists := protocol.Codes[code]; exists {
+		displayProtocolCodeWithLipgloss(protocol, code, info)
+	} else {
+		displayErrorWithLipgloss(fmt.Sprintf("%s reply code %d not found", protocol.Name, code))
+	}
+}
+
+func listProtocolCodes(protocol ReplyProtocol, category string) {
+	classes := protocol.classes()
+	if category != "" {
+		category = strings.ToLower(category)
+		class := int(category[0] - '0')
+		if !strings.HasSuffix(category, "xx") || len(category) != 3 || protocol.Classes[class] == "" {
+			var valid []string
+			for _, class := range classes {
+				valid = append(valid, fmt.Sprintf("%dxx", class))
+			}
+			displayErrorWithLipgloss(fmt.Sprintf("Invalid category. Use %s.", strings.Join(valid, ", ")))
+			return
+		}
+		classes = []int{class}
+		displayListHeaderWithLipgloss(fmt.Sprintf("%s %dxx - %s", protocol.Name, class, protocol.Classes[class]))
+	} else {
+		displayListHeaderWithLipgloss(fmt.Sprintf("All %s Reply Codes", protocol.Name))
+	}
+
+	codes := protocol.sortedCodes()
+	for _, class := range classes {
+		if len(classes) > 1 {
+			displayProtocolClassWithLipgloss(protocol, class)
+		}
+		for _, code := range codes {
+			if code/100 == class {
+				item := lipgloss.NewStyle().
+					Foreground(protocol.color(code)).
+					Render(fmt.Sprintf("  %d: %s", code, protocol.Codes[code].Description))
+				fmt.Println(item)
+			}
+		}
+	}
+}
+
+func runProtocolFzfSearch(protocol ReplyProtocol) {
+	// Prepare data for fuzzy search
+	var items []string
+	var codeMap = make(map[string]int)
+
+	for _, code := range protocol.sortedCodes() {
+		info := protocol.Codes[code]
+
+		item := fmt.Sprintf("%d\t%s\t%s\t%s\t%s",
+			code,
+			escapeString(info.Description),
+			protocol.class(code),
+			escapeString(info.Detail),
+			escapeString(protocol.Spec))
+
+		items = append(items, item)
+		codeMap[item] = code
+	}
+
+	previewCmd := fmt.Sprintf("echo -e '\\033[1;32m%s Reply Code:\\033[0m {1} {2}\\n", protocol.Name) +
+		"\\033[1;32mClass:\\033[0m            {3}\\n" +
+		"\\033[1;32mDetails:\\033[0m\\n{4}\\n" +
+		"\\033[1;32mSpec:\\033[0m             {5}'"
+
+	selection, ok := runFzfPicker(fzfPicker{
+		Items:   items,
+		Header:  "Code    Message          (Press ESC to exit, Enter to select)",
+		Label:   fmt.Sprintf("httpcode - %s Reply Code Viewer", protocol.Name),
+		Preview: previewCmd,
+	})
+	if !ok {
+		return
+	}
+
+	if code, exists := codeMap[selection]; exists {
+		displayProtocolCodeWithLipgloss(protocol, code, protocol.Codes[code])
+	} else {
+		fmt.Println(selection)
+	}
+}
+
+// displayProtocolCodeWithLipgloss displays a non-HTTP reply code using Lipgloss styling
+func displayProtocolCodeWithLipgloss(protocol ReplyProtocol, code int, info ReplyCodeInfo) {
+	// Point out when the same number means something else in HTTP
+	var notes []string
+	if description, exists := httpCodes[code]; exists && !strings.EqualFold(description, info.Description) {
+		notes = append(notes, fmt.Sprintf("In HTTP, %d is %s", code, description))
+	}
+
+	displayEntryWithLipgloss(displayEntry{
+		Title:       fmt.Sprintf("%s %d %s", protocol.Name, code, info.Description),
+		Class:       protocol.class(code),
+		Color:       protocol.color(code),
+		Description: info.Detail,
+		Notes:       notes,
+		Link:        protocol.Link,
+	})
+}
+
+// displayProtocolClassWithLipgloss displays a reply class header in a list
+func displayProtocolClassWithLipgloss(protocol ReplyProtocol, class int) {
+	header := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(protocol.Colors[class]).
+		Render(fmt.Sprintf("%dxx - %s", class, protocol.Classes[class]))
+	fmt.Println(header)
+}
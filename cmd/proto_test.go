@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestReplyProtocolsStructure(t *testing.T) {
+	for key, protocol := range replyProtocols {
+		t.Run(key, func(t *testing.T) {
+			if protocol.Key != key {
+				t.Errorf("Protocol key %q does not match %q", key, protocol.Key)
+			}
+
+			if protocol.Name == "" || protocol.Spec == "" || protocol.Link == "" {
+				t.Errorf("Protocol %s missing Name, Spec or Link", key)
+			}
+
+			for class := range protocol.Classes {
+				if _, exists := protocol.Colors[class]; !exists {
+					t.Errorf("Protocol %s class %dxx has no color", key, class)
+				}
+			}
+
+			for code, info := range protocol.Codes {
+				if _, exists := protocol.Classes[code/100]; !exists {
+					t.Errorf("Protocol %s code %d is outside the defined classes", key, code)
+				}
+				if info.Description == "" || info.Detail == "" {
+					t.Errorf("Protocol %s code %d missing Description or Detail", key, code)
+				}
+			}
+		})
+	}
+}
+
+func TestSelectedReplyProtocol(t *testing.T) {
+	tests := []struct {
+		proto     string
+		wantName  string
+		wantOK    bool
+		wantValid bool
+	}{
+		{"", "", false, true},
+		{"HTTP", "", false, true},
+		{"sip", "SIP", true, true},
+		{"SMTP", "SMTP", true, true},
+		{"gopher", "", false, false},
+	}
+
+	defer func() { protoFlag = "" }()
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("proto_%q", tt.proto), func(t *testing.T) {
+			protoFlag = tt.proto
+			var protocol ReplyProtocol
+			var ok, valid bool
+			stdout, _ := captureOutput(func() {
+				protocol, ok, valid = selectedReplyProtocol()
+			})
+
+			if ok != tt.wantOK || valid != tt.wantValid {
+				t.Errorf("selectedReplyProtocol() = %v, %v, expected %v, %v", ok, valid, tt.wantOK, tt.wantValid)
+			}
+			if protocol.Name != tt.wantName {
+				t.Errorf("selectedReplyProtocol() name = %q, expected %q", protocol.Name, tt.wantName)
+			}
+			if !tt.wantValid && !strings.Contains(stdout, "Invalid protocol") {
+				t.Errorf("Expected 'Invalid protocol' in output, got: %s", stdout)
+			}
+		})
+	}
+}
+
+func TestLookupProtocolCode(t *testing.T) {
+	tests := []struct {
+		name            string
+		proto           string
+		code            int
+		wantContains    []string
+		wantNotContains []string
+	}{
+		{
+			name:  "meaning differs from http",
+			proto: "sip",
+			code:  302,
+			wantContains: []string{
+				"SIP 302 Moved Temporarily",
+				"Redirection",
+				"In HTTP, 302 is Found",
+				"rfc3261",
+			},
+		},
+		{
+			name:  "same meaning as http",
+			proto: "rtsp",
+			code:  404,
+			wantContains: []string{
+				"RTSP 404 Not Found",
+				"Client Error",
+			},
+			wantNotContains: []string{
+				"In HTTP",
+			},
+		},
+		{
+			name:  "protocol class names",
+			proto: "smtp",
+			code:  450,
+			wantContains: []string{
+				"Transient Negative Completion",
+			},
+		},
+		{
+			name:  "global failure",
+			proto: "sip",
+			code:  603,
+			wantContains: []string{
+				"SIP 603 Decline",
+				"Global Failure",
+			},
+		},
+		{
+			name:  "not found",
+			proto: "ftp",
+			code:  299,
+			wantContains: []string{
+				"FTP reply code 299 not found",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			protocol, _ := findReplyProtocol(tt.proto)
+			stdout, _ := captureOutput(func() {
+				lookupProtocolCode(protocol, tt.code)
+			})
+
+			for _, want := range tt.wantContains {
+				if !strings.Contains(stdout, want) {
+					t.Errorf("Expected '%s' in output, got: %s", want, stdout)
+				}
+			}
+
+			for _, unwanted := range tt.wantNotContains {
+				if strings.Contains(stdout, unwanted) {
+					t.Errorf("Did not expect '%s' in output, got: %s", unwanted, stdout)
+				}
+			}
+		})
+	}
+}
+
+func TestListProtocolCodes(t *testing.T) {
+	tests := []struct {
+		name         string
+		proto        string
+		category     string
+		wantContains []string
+	}{
+		{
+			name:     "all codes",
+			proto:    "ftp",
+			category: "",
+			wantContains: []string{
+				"All FTP Reply Codes",
+				"1xx - Positive Preliminary",
+				"227: Entering Passive Mode",
+				"6xx - Protected Reply",
+			},
+		},
+		{
+			name:     "single class",
+			proto:    "sip",
+			category: "6xx",
+			wantContains: []string{
+				"SIP 6xx - Global Failure",
+				"600: Busy Everywhere",
+			},
+		},
+		{
+			name:     "class not used by protocol",
+			proto:    "smtp",
+			category: "1xx",
+			wantContains: []string{
+				"Invalid category. Use 2xx, 3xx, 4xx, 5xx.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			protocol, _ := findReplyProtocol(tt.proto)
+			stdout, _ := captureOutput(func() {
+				listProtocolCodes(protocol, tt.category)
+			})
+
+			for _, want := range tt.wantContains {
+				if !strings.Contains(stdout, want) {
+					t.Errorf("Expected '%s' in output, got: %s", want, stdout)
+				}
+			}
+		})
+	}
+}
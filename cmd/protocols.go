@@ -0,0 +1,366 @@
+package cmd
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Color for classes without an HTTP counterpart
+var globalFailureColor = lipgloss.Color("#c0392b")
+
+// ReplyCodeInfo contains information about a reply code of a non-HTTP protocol
+type ReplyCodeInfo struct {
+	Description string
+	Detail      string
+}
+
+// ReplyProtocol is a protocol whose three-digit reply codes are classed by
+// their first digit, like HTTP status codes
+type ReplyProtocol struct {
+	Key     string
+	Name    string
+	Spec    string
+	Link    string
+	Classes map[int]string
+	Colors  map[int]lipgloss.Color
+	Codes   map[int]ReplyCodeInfo
+}
+
+// Protocols selectable with --proto, besides the default http
+var replyProtocols = map[string]ReplyProtocol{
+	"ftp": {
+		Key:  "ftp",
+		Name: "FTP",
+		Spec: "RFC 959, Section 4.2",
+		Link: "https://www.rfc-editor.org/rfc/rfc959#section-4.2",
+		Classes: map[int]string{
+			1: "Positive Preliminary",
+			2: "Positive Completion",
+			3: "Positive Intermediate",
+			4: "Transient Negative Completion",
+			5: "Permanent Negative Completion",
+			6: "Protected Reply",
+		},
+		Colors: map[int]lipgloss.Color{
+			1: informationalColor,
+			2: successColor,
+			3: redirectionColor,
+			4: clientErrorColor,
+			5: serverErrorColor,
+			6: mutedColor,
+		},
+		Codes: ftpReplyCodes,
+	},
+	"smtp": {
+		Key:  "smtp",
+		Name: "SMTP",
+		Spec: "RFC 5321, Section 4.2",
+		Link: "https://www.rfc-editor.org/rfc/rfc5321#section-4.2",
+		Classes: map[int]string{
+			2: "Positive Completion",
+			3: "Positive Intermediate",
+			4: "Transient Negative Completion",
+			5: "Permanent Negative Completion",
+		},
+		Colors: map[int]lipgloss.Color{
+			2: successColor,
+			3: redirectionColor,
+			4: clientErrorColor,
+			5: serverErrorColor,
+		},
+		Codes: smtpReplyCodes,
+	},
+	"sip": {
+		Key:  "sip",
+		Name: "SIP",
+		Spec: "RFC 3261, Section 21",
+		Link: "https://www.rfc-editor.org/rfc/rfc3261#section-21",
+		Classes: map[int]string{
+			1: "Provisional",
+			2: "Success",
+			3: "Redirection",
+			4: "Request Failure",
+			5: "Server Failure",
+			6: "Global Failure",
+		},
+		Colors: map[int]lipgloss.Color{
+			1: informationalColor,
+			2: successColor,
+			3: redirectionColor,
+			4: clientErrorColor,
+			5: serverErrorColor,
+			6: globalFailureColor,
+		},
+		Codes: sipResponseCodes,
+	},
+	"rtsp": {
+		Key:  "rtsp",
+		Name: "RTSP",
+		Spec: "RFC 2326, Section 7.1.1",
+		Link: "https://www.rfc-editor.org/rfc/rfc2326#section-7.1.1",
+		Classes: map[int]string{
+			1: "Informational",
+			2: "Success",
+			3: "Redirection",
+			4: "Client Error",
+			5: "Server Error",
+		},
+		Colors: map[int]lipgloss.Color{
+			1: informationalColor,
+			2: successColor,
+			3: redirectionColor,
+			4: clientErrorColor,
+			5: serverErrorColor,
+		},
+		Codes: rtspStatusCodes,
+	},
+}
+
+// FTP reply codes (RFC 959, with RFC 2228 and RFC 2428 additions)
+var ftpReplyCodes = map[int]ReplyCodeInfo{
+	110: {"Restart marker reply", "Sent in response to a REST command; the text contains the marker the transfer can be restarted from."},
+	120: {"Service ready in nnn minutes", "The server is not ready yet; the text says when it expects to be."},
+	125: {"Data connection already open; transfer starting", "The data connection is open and the transfer is starting on it."},
+	150: {"File status okay; about to open data connection", "The server is about to open the data connection for the transfer."},
+	200: {"Command okay", "The command was accepted."},
+	202: {"Command not implemented, superfluous at this site", "The command is accepted but has no effect on this server."},
+	211: {"System status, or system help reply", "Reply to STAT or FEAT with server status or supported features."},
+	212: {"Directory status", "Reply to STAT for a directory."},
+	213: {"File status", "Reply to STAT, SIZE or MDTM for a file."},
+	214: {"Help message", "Reply to HELP with information on using the server."},
+	215: {"NAME system type", "Reply to SYST naming the server operating system, e.g. UNIX Type: L8."},
+	220: {"Service ready for new user", "Greeting sent when the control connection is established."},
+	221: {"Service closing control connection", "Reply to QUIT; the server logs the user out and closes the connection."},
+	225: {"Data connection open; no transfer in progress", "The data connection is open and idle."},
+	226: {"Closing data connection", "The requested file action succeeded and the data connection is being closed."},
+	227: {"Entering Passive Mode", "Reply to PASV with the address and port to connect to, as (h1,h2,h3,h4,p1,p2)."},
+	229: {"Entering Extended Passive Mode", "Reply to EPSV with the port to connect to, as (|||port|). Defined in RFC 2428."},
+	230: {"User logged in, proceed", "Login succeeded."},
+	234: {"Security data exchange complete", "Reply to AUTH TLS; the TLS handshake should start. Defined in RFC 2228."},
+	250: {"Requested file action okay, completed", "The file or directory command succeeded."},
+	257: {"PATHNAME created", "Reply to MKD or PWD with the quoted path name."},
+	331: {"User name okay, need password", "Send PASS to complete the login."},
+	332: {"Need account for login", "Send ACCT to complete the login."},
+	350: {"Requested file action pending further information", "Sent after REST or RNFR; the server waits for the next command (e.g. RNTO)."},
+	421: {"Service not available, closing control connection", "The server is shutting down or has too many connections; try again later."},
+	425: {"Can't open data connection", "The data connection could not be established, often a firewall or NAT issue with active or passive mode."},
+	426: {"Connection closed; transfer aborted", "The data connection was closed during the transfer."},
+	450: {"Requested file action not taken", "The file is temporarily unavailable, e.g. busy or locked."},
+	451: {"Requested action aborted: local error in processing", "The server hit a temporary error; retrying may succeed."},
+	452: {"Requested action not taken. Insufficient storage space in system", "The server is temporarily out of disk space."},
+	500: {"Syntax error, command unrecognized", "The command is unknown or the line is too long."},
+	501: {"Syntax error in parameters or arguments", "The command is known but its arguments are invalid."},
+	502: {"Command not implemented", "The server does not support this command."},
+	503: {"Bad sequence of commands", "The command is not valid at this point, e.g. PASS before USER or RNTO without RNFR."},
+	504: {"Command not implemented for that parameter", "The command is supported, but not with this argument, e.g. an unsupported TYPE."},
+	530: {"Not logged in", "Login failed, or the command requires logging in first."},
+	532: {"Need account for storing files", "Send ACCT before storing files."},
+	550: {"Requested action not taken. File unavailable", "The file does not exist or access is denied."},
+	551: {"Requested action aborted: page type unknown", "The page structure type is not supported."},
+	552: {"Requested file action aborted. Exceeded storage allocation", "The user's quota for the current directory or dataset is exceeded."},
+	553: {"Requested action not taken. File name not allowed", "The file name is invalid on this server."},
+	631: {"Integrity protected reply", "The reply is base64-encoded and integrity protected. Defined in RFC 2228."},
+	632: {"Confidentiality and integrity protected reply", "The reply is base64-encoded and both encrypted and integrity protected. Defined in RFC 2228."},
+	633: {"Confidentiality protected reply", "The reply is base64-encoded and encrypted. Defined in RFC 2228."},
+}
+
+// SMTP reply codes (RFC 5321, with RFC 4954 and RFC 7504 additions)
+var smtpReplyCodes = map[int]ReplyCodeInfo{
+	211: {"System status, or system help reply", "Status information about the server."},
+	214: {"Help message", "Reply to HELP with information on using the server."},
+	220: {"Service ready", "Greeting sent when the connection is established."},
+	221: {"Service closing transmission channel", "Reply to QUIT."},
+	235: {"Authentication succeeded", "Reply to AUTH when the credentials were accepted. Defined in RFC 4954."},
+	250: {"Requested mail action okay, completed", "The command succeeded; after the final dot of DATA, the message was accepted for delivery."},
+	251: {"User not local; will forward", "The server accepts the recipient and forwards the message."},
+	252: {"Cannot VRFY user, but will accept message and attempt delivery", "The server does not verify addresses but accepts the recipient."},
+	334: {"Server challenge", "Reply to AUTH carrying a base64-encoded challenge. Defined in RFC 4954."},
+	354: {"Start mail input", "Reply to DATA; send the message and end it with <CRLF>.<CRLF>."},
+	421: {"Service not available, closing transmission channel", "The server is shutting down or overloaded; the client should retry later."},
+	450: {"Requested mail action not taken: mailbox unavailable", "The mailbox is temporarily unavailable, e.g. busy or greylisting is in effect; retry later."},
+	451: {"Requested action aborted: local error in processing", "The server hit a temporary error; retry later."},
+	452: {"Requested action not taken: insufficient system storage", "The server is temporarily out of storage, or too many recipients were given."},
+	454: {"Temporary authentication failure", "Authentication could not complete because of a temporary server error. Defined in RFC 4954."},
+	455: {"Server unable to accommodate parameters", "The MAIL FROM or RCPT TO parameters cannot be accommodated right now."},
+	500: {"Syntax error, command unrecognized", "The command is unknown or the line is too long."},
+	501: {"Syntax error in parameters or arguments", "The command is known but its arguments are invalid."},
+	502: {"Command not implemented", "The server does not support this command."},
+	503: {"Bad sequence of commands", "The command is not valid at this point, e.g. RCPT TO before MAIL FROM."},
+	504: {"Command parameter not implemented", "The command is supported, but not with this parameter."},
+	521: {"Server does not accept mail", "The host never accepts mail. Defined in RFC 7504."},
+	530: {"Authentication required", "The server requires AUTH before accepting this command. Defined in RFC 4954."},
+	534: {"Authentication mechanism is too weak", "The selected AUTH mechanism is not allowed by policy. Defined in RFC 4954."},
+	535: {"Authentication credentials invalid", "The AUTH credentials were rejected. Defined in RFC 4954."},
+	550: {"Requested action not taken: mailbox unavailable", "The mailbox does not exist, access is denied, or the message was rejected by policy."},
+	551: {"User not local; please try <forward-path>", "The recipient is not local; the text gives the address to use instead."},
+	552: {"Requested mail action aborted: exceeded storage allocation", "The recipient's mailbox is full or the message is too large."},
+	553: {"Requested action not taken: mailbox name not allowed", "The address syntax is invalid."},
+	554: {"Transaction failed", "The transaction failed, e.g. the message was rejected as spam; in a greeting, no SMTP service is offered."},
+	555: {"MAIL FROM/RCPT TO parameters not recognized or not implemented", "An ESMTP parameter was not recognized."},
+	556: {"Domain does not accept mail", "The recipient domain never accepts mail. Defined in RFC 7504."},
+}
+
+// SIP response codes (RFC 3261 and extensions)
+var sipResponseCodes = map[int]ReplyCodeInfo{
+	100: {"Trying", "The request was received and is being processed; stops INVITE retransmissions."},
+	180: {"Ringing", "The callee's device is alerting the user."},
+	181: {"Call Is Being Forwarded", "The call is being forwarded to a different set of destinations."},
+	182: {"Queued", "The callee is temporarily unavailable and the call is queued."},
+	183: {"Session Progress", "Conveys call progress information, often with early media such as a ringback tone."},
+	199: {"Early Dialog Terminated", "An early dialog was terminated before a final response. Defined in RFC 6228."},
+	200: {"OK", "The request succeeded."},
+	202: {"Accepted", "The request was accepted for processing, e.g. a REFER or SUBSCRIBE. Deprecated by RFC 6665."},
+	204: {"No Notification", "The subscription was refreshed but no NOTIFY is sent. Defined in RFC 5839."},
+	300: {"Multiple Choices", "The address resolved to several locations listed in Contact."},
+	301: {"Moved Permanently", "The user is no longer at the Request-URI; use the Contact address."},
+	302: {"Moved Temporarily", "Retry the request at the Contact address for now."},
+	305: {"Use Proxy", "The request must be sent through the proxy given in Contact."},
+	380: {"Alternative Service", "The call failed, but alternative services are described in the body."},
+	400: {"Bad Request", "The request could not be understood due to malformed syntax."},
+	401: {"Unauthorized", "The request requires user authentication by a registrar or user agent."},
+	402: {"Payment Required", "Reserved for future use."},
+	403: {"Forbidden", "The server understood the request but refuses to fulfill it."},
+	404: {"Not Found", "The user does not exist at the domain in the Request-URI."},
+	405: {"Method Not Allowed", "The method is not allowed for the address in the Request-URI."},
+	406: {"Not Acceptable", "The resource cannot generate a response acceptable per the Accept header."},
+	407: {"Proxy Authentication Required", "The client must first authenticate with the proxy."},
+	408: {"Request Timeout", "The server could not produce a response in time, e.g. the user could not be located."},
+	410: {"Gone", "The user existed once but is no longer available and has no forwarding address."},
+	413: {"Request Entity Too Large", "The request body is larger than the server is willing to process."},
+	414: {"Request-URI Too Long", "The Request-URI is longer than the server is willing to interpret."},
+	415: {"Unsupported Media Type", "The body format or encoding is not supported."},
+	416: {"Unsupported URI Scheme", "The scheme of the Request-URI is unknown to the server."},
+	420: {"Bad Extension", "The server does not understand an extension listed in Proxy-Require or Require."},
+	421: {"Extension Required", "The server needs an extension not listed in Supported."},
+	422: {"Session Interval Too Small", "The Session-Expires value is too small. Defined in RFC 4028."},
+	423: {"Interval Too Brief", "The expiration time of the registration or subscription is too short."},
+	480: {"Temporarily Unavailable", "The callee was contacted but is currently unavailable, e.g. not logged in or do not disturb."},
+	481: {"Call/Transaction Does Not Exist", "The request does not match any existing dialog or transaction."},
+	482: {"Loop Detected", "The server detected a routing loop."},
+	483: {"Too Many Hops", "Max-Forwards reached zero."},
+	484: {"Address Incomplete", "The Request-URI is incomplete, e.g. an overlap-dialed number."},
+	485: {"Ambiguous", "The Request-URI matches several users."},
+	486: {"Busy Here", "The callee's device is busy; the callee may be reachable elsewhere."},
+	487: {"Request Terminated", "The request was terminated by a BYE or CANCEL."},
+	488: {"Not Acceptable Here", "Some aspect of the session description is not acceptable."},
+	489: {"Bad Event", "The event package in the Event header is not understood. Defined in RFC 6665."},
+	491: {"Request Pending", "A request is already pending within the same dialog, e.g. glare on re-INVITE."},
+	493: {"Undecipherable", "The request contains an encrypted body the server cannot decrypt."},
+	494: {"Security Agreement Required", "A security mechanism must be negotiated first. Defined in RFC 3329."},
+	500: {"Server Internal Error", "The server encountered an unexpected condition."},
+	501: {"Not Implemented", "The server does not support the request method."},
+	502: {"Bad Gateway", "A gateway or proxy received an invalid response downstream."},
+	503: {"Service Unavailable", "The server is temporarily overloaded or down for maintenance."},
+	504: {"Server Time-out", "A gateway or proxy did not receive a timely response from another server."},
+	505: {"Version Not Supported", "The SIP protocol version is not supported."},
+	513: {"Message Too Large", "The message length exceeds what the server can process."},
+	580: {"Precondition Failure", "The session preconditions could not be met. Defined in RFC 3312."},
+	600: {"Busy Everywhere", "The callee is busy at all known locations."},
+	603: {"Decline", "The callee does not wish to participate in the call."},
+	604: {"Does Not Exist Anywhere", "The user does not exist anywhere."},
+	606: {"Not Acceptable", "The user's agent was contacted but some aspect of the session is not acceptable."},
+	607: {"Unwanted", "The callee does not want this call and considers it unwanted. Defined in RFC 8197."},
+	608: {"Rejected", "An intermediary rejected the call, e.g. by call analytics. Defined in RFC 8688."},
+}
+
+// RTSP status codes (RFC 2326)
+var rtspStatusCodes = map[int]ReplyCodeInfo{
+	100: {"Continue", "The client should continue with its request."},
+	200: {"OK", "The request succeeded."},
+	201: {"Created", "The resource, e.g. a recording, was created."},
+	250: {"Low on Storage Space", "A RECORD request succeeded, but the server is running out of storage."},
+	300: {"Multiple Choices", "The resource is available at several locations."},
+	301: {"Moved Permanently", "The resource has moved; use the Location header."},
+	302: {"Moved Temporarily", "The resource is temporarily at another location given in Location."},
+	303: {"See Other", "The response is available at another URI."},
+	304: {"Not Modified", "The resource has not changed since the conditional request."},
+	305: {"Use Proxy", "The resource must be accessed through the proxy given in Location."},
+	400: {"Bad Request", "The request could not be understood due to malformed syntax."},
+	401: {"Unauthorized", "The request requires user authentication."},
+	402: {"Payment Required", "Reserved for future use."},
+	403: {"Forbidden", "The server understood the request but refuses to fulfill it."},
+	404: {"Not Found", "The server has not found anything matching the Request-URI."},
+	405: {"Method Not Allowed", "The method is not allowed for the resource; the Allow header lists valid methods."},
+	406: {"Not Acceptable", "The resource cannot generate a response acceptable per the Accept header."},
+	407: {"Proxy Authentication Required", "The client must first authenticate with the proxy."},
+	408: {"Request Time-out", "The client did not produce a request in time."},
+	410: {"Gone", "The resource is no longer available and has no forwarding address."},
+	411: {"Length Required", "The server requires a Content-Length header."},
+	412: {"Precondition Failed", "A precondition given in the request header fields evaluated to false."},
+	413: {"Request Entity Too Large", "The request body is larger than the server is willing to process."},
+	414: {"Request-URI Too Large", "The Request-URI is longer than the server is willing to interpret."},
+	415: {"Unsupported Media Type", "The body format is not supported."},
+	451: {"Parameter Not Understood", "The recipient does not support a parameter in the request."},
+	452: {"Conference Not Found", "The conference indicated by a Conference header was not found."},
+	453: {"Not Enough Bandwidth", "There is not enough bandwidth to set up the stream."},
+	454: {"Session Not Found", "The Session header is missing, invalid or timed out."},
+	455: {"Method Not Valid in This State", "The method is not valid in the current session state, e.g. PLAY before SETUP."},
+	456: {"Header Field Not Valid for Resource", "A required header field cannot be acted upon for this resource."},
+	457: {"Invalid Range", "The Range value is out of bounds."},
+	458: {"Parameter Is Read-Only", "SET_PARAMETER tried to change a read-only parameter."},
+	459: {"Aggregate Operation Not Allowed", "The method cannot be applied to the aggregate URL; use a stream URL."},
+	460: {"Only Aggregate Operation Allowed", "The method cannot be applied to a single stream; use the aggregate URL."},
+	461: {"Unsupported Transport", "None of the transports in the Transport header is supported."},
+	462: {"Destination Unreachable", "The data could not be sent to the client address."},
+	500: {"Internal Server Error", "The server encountered an unexpected condition."},
+	501: {"Not Implemented", "The server does not support the request method."},
+	502: {"Bad Gateway", "A gateway or proxy received an invalid response upstream."},
+	503: {"Service Unavailable", "The server is temporarily overloaded or down for maintenance."},
+	504: {"Gateway Time-out", "A gateway or proxy did not receive a timely response upstream."},
+	505: {"RTSP Version Not Supported", "The RTSP protocol version is not supported."},
+	551: {"Option Not Supported", "An option given in Require or Proxy-Require is not supported."},
+}
+
+// findReplyProtocol looks up a protocol by key in any casing
+func findReplyProtocol(key string) (ReplyProtocol, bool) {
+	protocol, exists := replyProtocols[strings.ToLower(key)]
+	return protocol, exists
+}
+
+// replyProtocolKeys returns the protocol keys in alphabetical order
+func replyProtocolKeys() []string {
+	var keys []string
+	for key := range replyProtocols {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// sortedCodes returns the protocol's reply codes in ascending order
+func (p ReplyProtocol) sortedCodes() []int {
+	var codes []int
+	for code := range p.Codes {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+	return codes
+}
+
+// classes returns the first digits of the protocol's reply classes in ascending order
+func (p ReplyProtocol) classes() []int {
+	var classes []int
+	for class := range p.Classes {
+		classes = append(classes, class)
+	}
+	sort.Ints(classes)
+	return classes
+}
+
+// color returns the color of a reply code's class
+func (p ReplyProtocol) color(code int) lipgloss.Color {
+	if color, exists := p.Colors[code/100]; exists {
+		return color
+	}
+	return unknownColor
+}
+
+// class returns the class name of a reply code
+func (p ReplyProtocol) class(code int) string {
+	if class, exists := p.Classes[code/100]; exists {
+		return class
+	}
+	return "Unknown"
+}
@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+// BCP 14 (RFC 2119, RFC 8174) requirement keywords, longest first
+var specKeywordPattern = regexp.MustCompile(`\b(MUST NOT|MUST|REQUIRED|SHALL NOT|SHALL|SHOULD NOT|SHOULD|NOT RECOMMENDED|RECOMMENDED|MAY|OPTIONAL)\b`)
+
+// Colors used for requirement keyword levels
+var specKeywordColors = map[string]lipgloss.Color{
+	"MUST":            clientErrorColor,
+	"MUST NOT":        clientErrorColor,
+	"REQUIRED":        clientErrorColor,
+	"SHALL":           clientErrorColor,
+	"SHALL NOT":       clientErrorColor,
+	"SHOULD":          redirectionColor,
+	"SHOULD NOT":      redirectionColor,
+	"RECOMMENDED":     redirectionColor,
+	"NOT RECOMMENDED": redirectionColor,
+	"MAY":             informationalColor,
+	"OPTIONAL":        informationalColor,
+}
+
+// rfcCmd represents the rfc command
+var rfcCmd = &cobra.Command{
+	Use:   "rfc [code|rfc#section]",
+	Short: "Show the normative spec text for a status code",
+	Long: `Show the normative text defining a status code, from RFC 9110, RFC 9111,
+RFC 6585, RFC 4918 and the other RFCs that register status codes. The
+excerpts are embedded in the binary, and requirement keywords such as MUST
+and SHOULD are highlighted.
+
+Look up a section directly with rfc#section (e.g. 9110#15.5.5), list the
+embedded sections under a prefix (e.g. 9110#15.5) or of a whole RFC
+(e.g. 9110). Running 'httpcode rfc' without arguments lists every section.`,
+	Args: cobra.ArbitraryArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 {
+			listSpecSections()
+			return
+		}
+		lookupSpec(strings.Join(args, " "))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(rfcCmd)
+}
+
+// lookupSpec shows the spec sections for a status code or section reference
+func lookupSpec(arg string) {
+	if code, err := strconv.Atoi(arg); err == nil && code < 1000 {
+		refs, exists := statusCodeSpecs[code]
+		if !exists {
+			displayErrorWithLipgloss(fmt.Sprintf("HTTP status code %d not found", code))
+			return
+		}
+		for _, ref := range refs {
+			rfc, section, _ := parseSpecRef(ref)
+			if s, err := loadSpecSection(rfc, section); err == nil {
+				displaySpecSectionWithLipgloss(s)
+			}
+		}
+		return
+	}
+
+	rfc, section, ok := parseSpecRef(arg)
+	if !ok {
+		displayErrorWithLipgloss(fmt.Sprintf("Invalid spec reference %s. Use a status code or rfc#section, e.g. 9110#15.5.5", arg))
+		return
+	}
+	if _, exists := specRFCTitles[rfc]; !exists {
+		displayErrorWithLipgloss(fmt.Sprintf("RFC %d is not embedded", rfc))
+		return
+	}
+
+	if s, err := loadSpecSection(rfc, section); err == nil {
+		displaySpecSectionWithLipgloss(s)
+		return
+	}
+	sections := specSectionsWithPrefix(rfc, section)
+	if len(sections) == 0 {
+		displayErrorWithLipgloss(fmt.Sprintf("RFC %d section %s is not embedded", rfc, section))
+		return
+	}
+	displayListHeaderWithLipgloss(fmt.Sprintf("RFC %d - %s", rfc, specRFCTitles[rfc]))
+	listSpecSectionItems(sections)
+}
+
+// listSpecSections lists every embedded section, grouped by RFC
+func listSpecSections() {
+	var rfcs []int
+	for rfc := range specRFCTitles {
+		rfcs = append(rfcs, rfc)
+	}
+	sort.Ints(rfcs)
+
+	displayListHeaderWithLipgloss("Embedded Spec Sections")
+	for _, rfc := range rfcs {
+		title := lipgloss.NewStyle().
+			Bold(true).
+			Render(fmt.Sprintf("RFC %d - %s", rfc, specRFCTitles[rfc]))
+		fmt.Println(title)
+		listSpecSectionItems(specSectionsWithPrefix(rfc, ""))
+	}
+}
+
+func listSpecSectionItems(sections []specSection) {
+	for _, s := range sections {
+		ref := lipgloss.NewStyle().
+			Foreground(specSectionColor(s)).
+			Render(fmt.Sprintf("  %-14s", s.Ref()))
+		fmt.Printf("%s %s\n", ref, s.Title)
+	}
+}
+
+// specSectionColor colors a section by the status code it defines
+func specSectionColor(s specSection) lipgloss.Color {
+	code, _ := strconv.Atoi(strings.SplitN(s.Title, " ", 2)[0])
+	return getStatusCodeColor(code)
+}
+
+func displaySpecSectionWithLipgloss(s specSection) {
+	header := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(specSectionColor(s)).
+		Render(fmt.Sprintf("           RFC %d §%s %s", s.RFC, s.Section, s.Title))
+	fmt.Println(header)
+
+	fmt.Printf("📚 Spec:        RFC %d, %s\n", s.RFC, specRFCTitles[s.RFC])
+	fmt.Println()
+	fmt.Println(highlightSpecKeywords(s.Text))
+	fmt.Println()
+
+	link := lipgloss.NewStyle().
+		Foreground(linkColor).
+		Render(fmt.Sprintf("🔗 Docs:        %s", s.Link()))
+	fmt.Println(link)
+	fmt.Println()
+}
+
+// highlightSpecKeywords styles requirement keywords by their level
+func highlightSpecKeywords(text string) string {
+	return specKeywordPattern.ReplaceAllStringFunc(text, func(keyword string) string {
+		return lipgloss.NewStyle().
+			Bold(true).
+			Foreground(specKeywordColors[keyword]).
+			Render(keyword)
+	})
+}
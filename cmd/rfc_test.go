@@ -0,0 +1,193 @@
+package cmd
+
+import (
+	"fmt"
+	"io/fs"
+	"strings"
+	"testing"
+)
+
+func TestStatusCodeSpecs(t *testing.T) {
+	for code := range httpCodesInfo {
+		t.Run(fmt.Sprint(code), func(t *testing.T) {
+			refs, exists := statusCodeSpecs[code]
+			if !exists || len(refs) == 0 {
+				t.Fatalf("Status code %d has no spec section", code)
+			}
+
+			for _, ref := range refs {
+				rfc, section, ok := parseSpecRef(ref)
+				if !ok {
+					t.Fatalf("Invalid spec reference %q for %d", ref, code)
+				}
+				if _, exists := specRFCTitles[rfc]; !exists {
+					t.Errorf("RFC %d for %d has no title", rfc, code)
+				}
+				if _, err := loadSpecSection(rfc, section); err != nil {
+					t.Errorf("Spec section %s for %d: %v", ref, code, err)
+				}
+			}
+
+			// The defining section is titled with the status code
+			rfc, section, _ := parseSpecRef(refs[0])
+			if s, err := loadSpecSection(rfc, section); err == nil && !strings.HasPrefix(s.Title, fmt.Sprint(code)) {
+				t.Errorf("Section %s is titled %q, not status code %d", refs[0], s.Title, code)
+			}
+		})
+	}
+
+	// Every embedded excerpt belongs to an RFC with a title
+	names, _ := fs.Glob(specFiles, "specs/*.txt")
+	for _, name := range names {
+		rfc, _, _ := parseSpecRef(strings.Replace(strings.TrimSuffix(strings.TrimPrefix(name, "specs/"), ".txt"), "-", "#", 1))
+		if _, exists := specRFCTitles[rfc]; !exists {
+			t.Errorf("Excerpt %s belongs to RFC %d, which has no title", name, rfc)
+		}
+	}
+}
+
+func TestParseSpecRef(t *testing.T) {
+	tests := []struct {
+		ref         string
+		wantRFC     int
+		wantSection string
+		wantOK      bool
+	}{
+		{"9110#15.5.5", 9110, "15.5.5", true},
+		{"rfc9110#section-15.5.5", 9110, "15.5.5", true},
+		{"RFC 9110 §15.5.5", 9110, "15.5.5", true},
+		{"9110#15.5.", 9110, "15.5", true},
+		{"6585", 6585, "", true},
+		{"9110#abc", 0, "", false},
+		{"foo", 0, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			rfc, section, ok := parseSpecRef(tt.ref)
+			if ok != tt.wantOK || rfc != tt.wantRFC || section != tt.wantSection {
+				t.Errorf("parseSpecRef(%q) = %d, %q, %v; want %d, %q, %v", tt.ref, rfc, section, ok, tt.wantRFC, tt.wantSection, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCompareSectionNumbers(t *testing.T) {
+	if compareSectionNumbers("15.5.9", "15.5.10") >= 0 {
+		t.Errorf("Expected 15.5.9 before 15.5.10")
+	}
+	if compareSectionNumbers("15.5", "15.5.1") >= 0 {
+		t.Errorf("Expected 15.5 before 15.5.1")
+	}
+}
+
+func TestLookupSpec(t *testing.T) {
+	tests := []struct {
+		name            string
+		arg             string
+		wantContains    []string
+		wantNotContains []string
+	}{
+		{
+			name: "status code",
+			arg:  "405",
+			wantContains: []string{
+				"RFC 9110 §15.5.6 405 Method Not Allowed",
+				"The origin server MUST generate an",
+				"https://www.rfc-editor.org/rfc/rfc9110#section-15.5.6",
+			},
+		},
+		{
+			name: "status code with caching section",
+			arg:  "304",
+			wantContains: []string{
+				"RFC 9110 §15.4.5 304 Not Modified",
+				"RFC 9111 §4.3.4 Freshening Stored Responses upon Validation",
+			},
+		},
+		{
+			name: "status code outside RFC 9110",
+			arg:  "429",
+			wantContains: []string{
+				"RFC 6585 §4 429 Too Many Requests",
+				"Additional HTTP Status Codes",
+			},
+		},
+		{
+			name: "section reference",
+			arg:  "9110#15.5.5",
+			wantContains: []string{
+				"RFC 9110 §15.5.5 404 Not Found",
+				"410 (Gone) status code is preferred over 404",
+			},
+		},
+		{
+			name: "section prefix",
+			arg:  "9110#15.6",
+			wantContains: []string{
+				"RFC 9110 - HTTP Semantics",
+				"9110#15.6.1",
+				"505 HTTP Version Not Supported",
+			},
+			wantNotContains: []string{
+				"9110#15.5.1",
+			},
+		},
+		{
+			name: "unused code section",
+			arg:  "9110#15.4.7",
+			wantContains: []string{
+				"306 (Unused)",
+			},
+		},
+		{
+			name:         "unknown status code",
+			arg:          "999",
+			wantContains: []string{"HTTP status code 999 not found"},
+		},
+		{
+			name:         "unknown section",
+			arg:          "9110#99",
+			wantContains: []string{"RFC 9110 section 99 is not embedded"},
+		},
+		{
+			name:         "unknown RFC",
+			arg:          "1234",
+			wantContains: []string{"RFC 1234 is not embedded"},
+		},
+		{
+			name:         "invalid reference",
+			arg:          "foo",
+			wantContains: []string{"Invalid spec reference foo"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout, _ := captureOutput(func() {
+				lookupSpec(tt.arg)
+			})
+
+			for _, want := range tt.wantContains {
+				if !strings.Contains(stdout, want) {
+					t.Errorf("Expected '%s' in output, got: %s", want, stdout)
+				}
+			}
+			for _, unwanted := range tt.wantNotContains {
+				if strings.Contains(stdout, unwanted) {
+					t.Errorf("Expected no '%s' in output, got: %s", unwanted, stdout)
+				}
+			}
+		})
+	}
+}
+
+func TestSpecKeywordPattern(t *testing.T) {
+	text := "A proxy MUST NOT generate it; a server SHOULD send it and MAY retry. Must is not a keyword."
+	got := specKeywordPattern.FindAllString(text, -1)
+	want := []string{"MUST NOT", "SHOULD", "MAY"}
+
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected keywords %v, got %v", want, got)
+	}
+}
@@ -4,10 +4,20 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 )
 
+// Request method to check looked up status codes against
+var lookupMethodFlag string
+
+// Programming languages to show status code constant names for
+var lookupLangFlag string
+
+// Show the gRPC status codes related to looked up status codes
+var lookupGRPCFlag bool
+
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
 	Use:   "httpcode [code]",
@@ -19,14 +29,44 @@ Complete documentation is available at https://github.com/lethang7794/httpcode`,
 	// This is important - it tells Cobra not to try to validate args against commands
 	Args:  cobra.ArbitraryArgs,
 	Run: func(cmd *cobra.Command, args []string) {
+		// --lang mixes description locales with languages for constant names
+		locale, languages := splitLangSelection(lookupLangFlag)
+		selectLocale(locale)
+
+		protocol, isProtocol, valid := selectedReplyProtocol()
+		if !valid {
+			return
+		}
+
 		if len(args) == 0 {
-			runFzfSearch()
+			if isProtocol {
+				runProtocolFzfSearch(protocol)
+			} else {
+				runFzfSearch()
+			}
+			return
+		}
+
+		if code, err := strconv.Atoi(args[0]); err == nil && isProtocol {
+			lookupProtocolCode(protocol, code)
 			return
 		}
 
-		// Try to parse as a status code
+		// Try to parse as a status code, then as a language constant name,
+		// then as a current or historical status name
 		if code, err := strconv.Atoi(args[0]); err == nil {
 			lookupCode(code)
+			displayLookupExtras(code, languages)
+		} else if code, exists := resolveStatusConstant(args[0]); exists {
+			lookupCode(code)
+			if languages == "" {
+				languages = "all"
+			}
+			displayLookupExtras(code, languages)
+		} else if code, exists := resolveStatusName(strings.Join(args, " ")); exists {
+			lookupCode(code)
+			displayFormerNameNote(strings.Join(args, " "), code)
+			displayLookupExtras(code, languages)
 		} else {
 			// Only show "unknown command" for non-numeric inputs
 			fmt.Printf("Unknown command: %s\n", args[0])
@@ -35,6 +75,26 @@ Complete documentation is available at https://github.com/lethang7794/httpcode`,
 	},
 }
 
+// displayLookupExtras displays the sections requested by lookup flags
+func displayLookupExtras(code int, lang string) {
+	if _, exists := httpCodesInfo[code]; !exists {
+		// gRPC maps CANCELLED to the unregistered 499
+		if lookupGRPCFlag && hasGRPCMapping(code) {
+			displayGRPCMapping(code)
+		}
+		return
+	}
+	if lookupMethodFlag != "" {
+		checkMethodCompatibility(lookupMethodFlag, code)
+	}
+	if lang != "" {
+		displayStatusConstants(code, lang)
+	}
+	if lookupGRPCFlag {
+		displayGRPCMapping(code)
+	}
+}
+
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
@@ -49,9 +109,13 @@ func init() {
 	// Cobra supports persistent flags, which, if defined here,
 	// will be global for your application.
 
-	// rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.httpcode.yaml)")
+	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.httpcode.yaml)")
 
 	// Cobra also supports local flags, which will only run
 	// when this action is called directly.
 	// rootCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+	rootCmd.Flags().StringVarP(&lookupMethodFlag, "method", "X", "", "check the status code against a request method (e.g. GET, HEAD)")
+	rootCmd.Flags().StringVar(&lookupLangFlag, "lang", "", "show constant names (go, python, java, node, rust, csharp or all) and/or descriptions in en, vi, es, ja, de, fr; comma-separated")
+	rootCmd.Flags().StringVar(&protoFlag, "proto", "", protoFlagUsage)
+	rootCmd.Flags().BoolVar(&lookupGRPCFlag, "grpc", false, "show the gRPC status codes mapped to and from the status code")
 }
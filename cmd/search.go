@@ -41,6 +41,15 @@ var searchCmd = &cobra.Command{
 	Short: "Interactive fuzzy search with detailed preview",
 	Long:  `Use fuzzy search to interactively search for HTTP status codes with detailed preview.`,
 	Run: func(cmd *cobra.Command, args []string) {
+		if !selectDescriptionLocale() {
+			return
+		}
+		if protocol, isProtocol, valid := selectedReplyProtocol(); !valid {
+			return
+		} else if isProtocol {
+			runProtocolFzfSearch(protocol)
+			return
+		}
 		runFzfSearch()
 	},
 }
@@ -59,7 +68,7 @@ func runFzfSearch() {
 
 	// Format items for display with preview information
 	for _, code := range codes {
-		info := httpCodesInfo[code]
+		info := localizedCodeInfo(code)
 		category := ""
 		switch code / 100 {
 		case 1:
@@ -76,7 +85,16 @@ func runFzfSearch() {
 
 		// Include all information for preview mode
 		// Escape special characters in the detail text
-		escapedDescription := escapeString(info.Description)
+		// Keep the English phrase next to a translation so both can be matched
+		description := info.Description
+		if english := httpCodesInfo[code].Description; english != description {
+			description = fmt.Sprintf("%s (%s)", description, english)
+		}
+		// Keep former names so searching for them finds the current entry
+		if former := formerStatusNames(code); len(former) > 0 {
+			description = fmt.Sprintf("%s, formerly %s", description, strings.Join(former, ", "))
+		}
+		escapedDescription := escapeString(description)
 		escapedDetail := escapeString(info.Detail)
 		escapedLink := escapeString(info.MDNLink)
 		
@@ -91,10 +109,47 @@ func runFzfSearch() {
 		codeMap[item] = code
 	}
 
+	// Preview command for detailed view
+	previewCmd := "echo -e '\\033[1;32mHTTP Status Code:\\033[0m {1} {2}\\n" +
+		"\\033[1;32mClass:\\033[0m            {3}\\n" +
+		"\\033[1;32mDetails:\\033[0m\\n{4}\\n" +
+		"\\033[1;32mMDN Docs:\\033[0m\\n{5}'"
+	
+	selection, ok := runFzfPicker(fzfPicker{
+		Items:   items,
+		Header:  "Code    Message          (Press ESC to exit, Enter to select)",
+		Label:   "httpcode - HTTP Status Code Viewer",
+		Preview: previewCmd,
+	})
+	if !ok {
+		// No selection made (user cancelled)
+		return
+	}
+
+	// Process the selected item after fzf exits
+	if statusCode, exists := codeMap[selection]; exists {
+		displayCodeWithLipgloss(statusCode, localizedCodeInfo(statusCode))
+	} else {
+		fmt.Println(selection)
+	}
+}
+
+// fzfPicker describes an interactive fuzzy search over tab-delimited items.
+// The first two fields of each item are shown in the list, all fields are
+// available to the preview command as {1}, {2}, ...
+type fzfPicker struct {
+	Items   []string
+	Header  string
+	Label   string
+	Preview string
+}
+
+// runFzfPicker runs fzf over the picker items and returns the selected item
+func runFzfPicker(p fzfPicker) (string, bool) {
 	// Create input channel for fzf
 	inputChan := make(chan string)
 	go func() {
-		for _, item := range items {
+		for _, item := range p.Items {
 			inputChan <- item
 		}
 		close(inputChan)
@@ -116,36 +171,31 @@ func runFzfSearch() {
 
 	// Build fzf options
 	var fzfArgs []string
-	
+
 	// Basic options
 	fzfArgs = append(fzfArgs, "--ansi", "--reverse", "--border")
-	
+
 	// Set height
 	fzfArgs = append(fzfArgs, "--height=80%")
-	
+
 	// Add header
-	fzfArgs = append(fzfArgs, "--header=Code    Message          (Press ESC to exit, Enter to select)")
-	
+	fzfArgs = append(fzfArgs, "--header="+p.Header)
+
 	// Add header label with program information
-	fzfArgs = append(fzfArgs, "--border-label=httpcode - HTTP Status Code Viewer")
-	
+	fzfArgs = append(fzfArgs, "--border-label="+p.Label)
+
 	// Add preview options for detailed view
-	previewCmd := "echo -e '\\033[1;32mHTTP Status Code:\\033[0m {1} {2}\\n" +
-		"\\033[1;32mClass:\\033[0m            {3}\\n" +
-		"\\033[1;32mDetails:\\033[0m\\n{4}\\n" +
-		"\\033[1;32mMDN Docs:\\033[0m\\n{5}'"
-	
-	fzfArgs = append(fzfArgs, 
+	fzfArgs = append(fzfArgs,
 		"--delimiter=\\t",
 		"--with-nth=1,2",
-		"--preview=" + previewCmd,
+		"--preview="+p.Preview,
 		"--preview-window=right:60%:wrap")
 
 	// Parse options
 	options, err := fzf.ParseOptions(false, fzfArgs)
 	if err != nil {
 		exit(fzf.ExitError, err)
-		return
+		return "", false
 	}
 
 	// Set up input and output channels
@@ -154,23 +204,18 @@ func runFzfSearch() {
 
 	// Run fzf
 	code, err := fzf.Run(options)
-	
-	// Process the selected item after fzf exits
+	exit(code, err)
+
 	select {
 	case selection := <-outputChan:
-		if statusCode, exists := codeMap[selection]; exists {
-			info := httpCodesInfo[statusCode]
-			displayCodeWithLipgloss(statusCode, info)
-		} else {
-			fmt.Println(selection)
-		}
+		return selection, true
 	default:
-		// No selection made (user cancelled)
+		return "", false
 	}
-	
-	exit(code, err)
 }
 
 func init() {
+	searchCmd.Flags().StringVar(&protoFlag, "proto", "", protoFlagUsage)
+	searchCmd.Flags().StringVar(&descriptionLangFlag, "lang", "", descriptionLangFlagUsage)
 	rootCmd.AddCommand(searchCmd)
 }
\ No newline at end of file
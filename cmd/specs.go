@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Normative spec excerpts, one file per section named <rfc>-<section>.txt
+//
+//go:embed specs/*.txt
+var specFiles embed.FS
+
+// Titles of the RFCs with embedded excerpts
+var specRFCTitles = map[int]string{
+	2295: "Transparent Content Negotiation in HTTP",
+	2518: "HTTP Extensions for Distributed Authoring -- WEBDAV",
+	2774: "An HTTP Extension Framework",
+	3229: "Delta encoding in HTTP",
+	4918: "HTTP Extensions for Web Distributed Authoring and Versioning (WebDAV)",
+	5842: "Binding Extensions to Web Distributed Authoring and Versioning (WebDAV)",
+	6585: "Additional HTTP Status Codes",
+	7725: "An HTTP Status Code to Report Legal Obstacles",
+	8297: "An HTTP Status Code for Indicating Hints",
+	8470: "Using Early Data in HTTP",
+	9110: "HTTP Semantics",
+	9111: "HTTP Caching",
+}
+
+// Spec sections for each status code, the defining section first
+var statusCodeSpecs = map[int][]string{
+	100: {"9110#15.2.1"},
+	101: {"9110#15.2.2"},
+	102: {"2518#10.1"},
+	103: {"8297#2"},
+	200: {"9110#15.3.1"},
+	201: {"9110#15.3.2"},
+	202: {"9110#15.3.3"},
+	203: {"9110#15.3.4"},
+	204: {"9110#15.3.5"},
+	205: {"9110#15.3.6"},
+	206: {"9110#15.3.7"},
+	207: {"4918#11.1"},
+	208: {"5842#7.1"},
+	226: {"3229#10.4.1"},
+	300: {"9110#15.4.1"},
+	301: {"9110#15.4.2"},
+	302: {"9110#15.4.3"},
+	303: {"9110#15.4.4"},
+	304: {"9110#15.4.5", "9111#4.3.4"},
+	305: {"9110#15.4.6"},
+	307: {"9110#15.4.8"},
+	308: {"9110#15.4.9"},
+	400: {"9110#15.5.1"},
+	401: {"9110#15.5.2"},
+	402: {"9110#15.5.3"},
+	403: {"9110#15.5.4"},
+	404: {"9110#15.5.5"},
+	405: {"9110#15.5.6"},
+	406: {"9110#15.5.7"},
+	407: {"9110#15.5.8"},
+	408: {"9110#15.5.9"},
+	409: {"9110#15.5.10"},
+	410: {"9110#15.5.11"},
+	411: {"9110#15.5.12"},
+	412: {"9110#15.5.13"},
+	413: {"9110#15.5.14"},
+	414: {"9110#15.5.15"},
+	415: {"9110#15.5.16"},
+	416: {"9110#15.5.17"},
+	417: {"9110#15.5.18"},
+	418: {"9110#15.5.19"},
+	421: {"9110#15.5.20"},
+	422: {"9110#15.5.21"},
+	423: {"4918#11.3"},
+	424: {"4918#11.4"},
+	425: {"8470#5.2"},
+	426: {"9110#15.5.22"},
+	428: {"6585#3"},
+	429: {"6585#4"},
+	431: {"6585#5"},
+	451: {"7725#3"},
+	500: {"9110#15.6.1"},
+	501: {"9110#15.6.2"},
+	502: {"9110#15.6.3"},
+	503: {"9110#15.6.4"},
+	504: {"9110#15.6.5"},
+	505: {"9110#15.6.6"},
+	506: {"2295#8.1"},
+	507: {"4918#11.5"},
+	508: {"5842#7.2"},
+	510: {"2774#7"},
+	511: {"6585#6"},
+}
+
+// specSection is an embedded excerpt of one RFC section
+type specSection struct {
+	RFC     int
+	Section string
+	Title   string
+	Text    string
+}
+
+// Ref returns the section reference in rfc#section form
+func (s specSection) Ref() string {
+	return fmt.Sprintf("%d#%s", s.RFC, s.Section)
+}
+
+// Link returns the section's URL on the RFC Editor site
+func (s specSection) Link() string {
+	return fmt.Sprintf("https://www.rfc-editor.org/rfc/rfc%d#section-%s", s.RFC, s.Section)
+}
+
+// Accepted spellings of a section reference: 9110#15.5.5, rfc9110#section-15.5.5,
+// RFC 9110 §15.5.5
+var specRefPattern = regexp.MustCompile(`^(?i:rfc)?\s*(\d+)\s*(?:#|§)\s*(?i:section-)?([0-9.]*[0-9])?\.?$`)
+
+// parseSpecRef splits a section reference into its RFC number and section.
+// The section is empty for a reference to a whole RFC.
+func parseSpecRef(ref string) (int, string, bool) {
+	ref = strings.TrimSpace(ref)
+	if !strings.ContainsAny(ref, "#§") {
+		ref += "#"
+	}
+	match := specRefPattern.FindStringSubmatch(ref)
+	if match == nil {
+		return 0, "", false
+	}
+	rfc, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, "", false
+	}
+	return rfc, match[2], true
+}
+
+// loadSpecSection returns the embedded excerpt of an RFC section
+func loadSpecSection(rfc int, section string) (specSection, error) {
+	data, err := specFiles.ReadFile(fmt.Sprintf("specs/%d-%s.txt", rfc, section))
+	if err != nil {
+		return specSection{}, fmt.Errorf("RFC %d section %s is not embedded", rfc, section)
+	}
+	heading, text, _ := strings.Cut(string(data), "\n")
+	return specSection{
+		RFC:     rfc,
+		Section: section,
+		Title:   strings.TrimSpace(strings.TrimPrefix(heading, section+".")),
+		Text:    strings.Trim(text, "\n"),
+	}, nil
+}
+
+// specSectionsWithPrefix returns the embedded sections of an RFC that are at
+// or below the given section, in document order
+func specSectionsWithPrefix(rfc int, prefix string) []specSection {
+	names, _ := fs.Glob(specFiles, fmt.Sprintf("specs/%d-*.txt", rfc))
+
+	var sections []specSection
+	for _, name := range names {
+		section := strings.TrimSuffix(strings.TrimPrefix(name, fmt.Sprintf("specs/%d-", rfc)), ".txt")
+		if prefix != "" && section != prefix && !strings.HasPrefix(section, prefix+".") {
+			continue
+		}
+		if s, err := loadSpecSection(rfc, section); err == nil {
+			sections = append(sections, s)
+		}
+	}
+
+	sort.Slice(sections, func(i, j int) bool {
+		return compareSectionNumbers(sections[i].Section, sections[j].Section) < 0
+	})
+	return sections
+}
+
+// compareSectionNumbers orders dotted section numbers numerically, so that
+// 15.5.10 sorts after 15.5.9
+func compareSectionNumbers(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, _ := strconv.Atoi(as[i])
+		bn, _ := strconv.Atoi(bs[i])
+		if an != bn {
+			return an - bn
+		}
+	}
+	return len(as) - len(bs)
+}
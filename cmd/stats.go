@@ -0,0 +1,295 @@
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+// Width of the longest bar in the status histogram
+const statsBarWidth = 40
+
+// statsCmd represents the stats command
+var statsCmd = &cobra.Command{
+	Use:   "stats [file...]",
+	Short: "Summarize the status codes in access logs",
+	Long: `Count the status codes in access logs and show a histogram colored by
+class, with each code's share of requests and the overall error rate.
+
+The log format is detected from the first lines of each file: nginx/Apache
+common or combined, Caddy, Traefik, Envoy, AWS ALB, CloudFront or JSON lines.
+Use --format to pick one, or define your own in the config file:
+
+  log_formats:
+    - name: myapp
+      pattern: '^(?P<time>\S+) (?P<method>\S+) (?P<path>\S+) (?P<status>\d{3})'
+    - name: applog
+      json: {status: http.status_code, method: http.method, path: http.target, time: ts}
+
+JSON formats may also map upstream_status, upstream_length and
+response_flags (Envoy's %RESPONSE_FLAGS%), and patterns may capture them in
+(?P<upstream_status>...), (?P<upstream_length>...) and (?P<flags>...) groups,
+for --upstream and the Envoy response flags breakdown.
+
+Compressed logs (gzip, zstd, bzip2) are read transparently and glob patterns
+are expanded, with rotated sets ordered from the oldest file to the live one.
+--rotated adds the rotated files of each log, and --jobs files are read
+concurrently:
+  httpcode stats --rotated /var/log/nginx/access.log
+  httpcode stats '/var/log/nginx/access.log*'
+
+--bucket 1m|5m|1h adds a timeline: a stacked bar per bucket colored by class,
+and a sparkline per status code showing when it started, sized to the
+terminal.
+
+--endpoints N shows the N endpoints with the most 5xx and 4xx responses and
+their dominant codes. Paths are grouped by method and template, replacing
+IDs, UUIDs, hashes and numbers (/users/{id}/orders); add your own rules to
+the config file, applied before the built-in ones:
+
+  path_templates:
+    - match: '^/repos/[^/]+/[^/]+'
+      template: '/repos/{owner}/{repo}'
+
+--upstream attributes every 4xx and 5xx to the proxy (client aborts, connect
+failures, timeouts, exhausted retries, rate limits) or to the upstream that
+sent it, from nginx's $upstream_status (e.g. the ingress-nginx format),
+Traefik's OriginStatus or Envoy's response flags.
+
+Envoy logs with response flags (UH, UF, URX, NR, ...) also get a breakdown of
+the flags with the statuses they came with, decoded as by 'httpcode envoy'.
+
+-o csv writes the endpoint, bucket or code counts as CSV instead; it writes
+one table, so --bucket and --endpoints cannot be combined with it.
+
+Reads standard input when no files are given or the file is "-", e.g.
+  kubectl logs deploy/ingress-nginx | httpcode stats`,
+	Args: cobra.ArbitraryArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if statsOutput != "text" && statsOutput != "csv" {
+			displayErrorWithLipgloss(fmt.Sprintf("Invalid output %s. Use text or csv", statsOutput))
+			return
+		}
+		if statsBucket < 0 || (statsBucket > 0 && statsBucket < time.Second) {
+			displayErrorWithLipgloss(fmt.Sprintf("Invalid bucket %s. Use at least 1s, e.g. 1m, 5m or 1h", statsBucket))
+			return
+		}
+		if statsOutput == "csv" && statsBucket > 0 && statsEndpoints > 0 {
+			displayErrorWithLipgloss("-o csv writes one table. Use either --bucket or --endpoints")
+			return
+		}
+
+		stats, err := readStatusStats(args, os.Stdin)
+		if err != nil {
+			displayErrorWithLipgloss(err.Error())
+			return
+		}
+		if statsOutput == "csv" {
+			write := writeStatusStatsCSV
+			if statsEndpoints > 0 {
+				write = writeEndpointStatsCSV
+			}
+			if err := write(os.Stdout, stats); err != nil {
+				displayErrorWithLipgloss(err.Error())
+			}
+			return
+		}
+		displayStatusStatsWithLipgloss(stats)
+		if statsEndpoints > 0 && stats.Total > 0 {
+			displayTopEndpointsWithLipgloss(stats, statsEndpoints)
+		}
+		if len(stats.EnvoyFlags) > 0 {
+			displayEnvoyFlagStatsWithLipgloss(stats)
+		}
+		if statsUpstream && stats.Total > 0 {
+			displayErrorAttributionWithLipgloss(stats)
+		}
+		if statsBucket > 0 && stats.Total > 0 {
+			displayStatusTimelineWithLipgloss(stats, terminalWidth(os.Stdout))
+		}
+	},
+}
+
+func init() {
+	statsCmd.Flags().StringVarP(&logFormatFlag, "format", "f", "auto", logFormatFlagUsage)
+	statsCmd.Flags().BoolVar(&logRotated, "rotated", false, "also read the rotated files of each log (access.log.1, access.log.2.gz, ...)")
+	statsCmd.Flags().IntVarP(&logJobs, "jobs", "j", runtime.NumCPU(), "number of log files read concurrently")
+	statsCmd.Flags().DurationVar(&statsBucket, "bucket", 0, "show a timeline with buckets of this size (e.g. 1m, 5m, 1h)")
+	statsCmd.Flags().StringVarP(&statsOutput, "output", "o", "text", "output format: text or csv")
+	statsCmd.Flags().IntVar(&statsEndpoints, "endpoints", 0, "show the top N endpoints by 5xx and 4xx responses")
+	statsCmd.Flags().BoolVar(&statsUpstream, "upstream", false, "attribute errors to the proxy or the upstream")
+	rootCmd.AddCommand(statsCmd)
+}
+
+// readStatusStats collects status statistics from log files, or from stdin
+// when no files are given
+func readStatusStats(paths []string, stdin *os.File) (*statusStats, error) {
+	if len(paths) == 0 {
+		if isTerminal(stdin) {
+			return nil, fmt.Errorf("no input. Pass access log files or pipe a log into httpcode stats")
+		}
+		paths = []string{"-"}
+	}
+
+	var templater *pathTemplater
+	if statsEndpoints > 0 {
+		var err error
+		if templater, err = loadPathTemplater(); err != nil {
+			return nil, err
+		}
+	}
+	return collectLogFiles(paths, stdin, func(stats *statusStats) {
+		stats.BucketSize = statsBucket
+		stats.Templater = templater
+		stats.Attribute = statsUpstream
+	})
+}
+
+// collectLogFiles collects status statistics from log files, after expanding
+// them, on the worker pool. configure sets up the stats of every file.
+func collectLogFiles(paths []string, stdin *os.File, configure func(*statusStats)) (*statusStats, error) {
+	paths, err := expandLogPaths(paths, logRotated)
+	if err != nil {
+		return nil, err
+	}
+	formats, err := selectLogFormats()
+	if err != nil {
+		return nil, err
+	}
+
+	// Every file is counted on its own, then merged in input order
+	results := make([]*statusStats, len(paths))
+	err = forEachLogFile(paths, logJobs, func(i int, path string) error {
+		results[i] = newStatusStats()
+		configure(results[i])
+		return collectStatusStatsFromPath(path, stdin, formats, results[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats := newStatusStats()
+	configure(stats)
+	for _, result := range results {
+		stats.merge(result)
+	}
+	return stats, nil
+}
+
+// collectStatusStatsFromPath collects stats from one file, "-" being stdin
+func collectStatusStatsFromPath(path string, stdin *os.File, formats []logFormat, stats *statusStats) error {
+	r, err := openLogFile(path, stdin)
+	if err != nil {
+		return fmt.Errorf("cannot read %s: %v", path, err)
+	}
+	defer r.Close()
+	if err := collectStatusStats(r, formats, stats); err != nil {
+		return fmt.Errorf("cannot read %s: %v", path, err)
+	}
+	return nil
+}
+
+// statusName returns the registered name of a status code for reports, or
+// the name servers use for a common unregistered one such as nginx's 499
+func statusName(code int) string {
+	if info, exists := httpCodesInfo[code]; exists {
+		return info.Description
+	}
+	if unofficial, exists := unofficialStatusCodes[code]; exists {
+		return unofficial.Name
+	}
+	return "(unregistered)"
+}
+
+// formatPercent formats a fraction as a percentage
+func formatPercent(rate float64) string {
+	return fmt.Sprintf("%.1f%%", rate*100)
+}
+
+// histogramBar returns a bar for a count scaled against the largest count,
+// at least one block wide for non-zero counts
+func histogramBar(count, largest, width int) string {
+	if count == 0 || largest == 0 {
+		return ""
+	}
+	blocks := int(math.Round(float64(count) / float64(largest) * float64(width)))
+	if blocks < 1 {
+		blocks = 1
+	}
+	return strings.Repeat("█", blocks)
+}
+
+func displayStatusStatsWithLipgloss(stats *statusStats) {
+	if stats.Total == 0 {
+		displayErrorWithLipgloss(fmt.Sprintf("No access log lines found (%d lines skipped)", stats.Skipped))
+		return
+	}
+
+	var codes []int
+	largest := 0
+	for code, count := range stats.Counts {
+		codes = append(codes, code)
+		largest = max(largest, count)
+	}
+	sort.Ints(codes)
+
+	displayListHeaderWithLipgloss(fmt.Sprintf("Status Codes in %d Requests", stats.Total))
+	for _, code := range codes {
+		count := stats.Counts[code]
+		row := lipgloss.NewStyle().
+			Foreground(getStatusCodeColor(code)).
+			Render(fmt.Sprintf("  %d %-32s %-*s", code, statusName(code), statsBarWidth, histogramBar(count, largest, statsBarWidth)))
+		fmt.Printf("%s %8d %6s\n", row, count, formatPercent(stats.rate(count)))
+	}
+	fmt.Println()
+
+	var classes []string
+	for class := 1; class <= 5; class++ {
+		if count := stats.classCount(class); count > 0 {
+			classes = append(classes, lipgloss.NewStyle().
+				Foreground(getStatusCodeColor(class*100)).
+				Render(fmt.Sprintf("%dxx %s", class, formatPercent(stats.rate(count)))))
+		}
+	}
+	fmt.Printf("📊 Classes:     %s\n", strings.Join(classes, "  "))
+
+	errorLine := fmt.Sprintf("❌ Error rate:  %s (4xx %s, 5xx %s)",
+		formatPercent(stats.errorRate()),
+		formatPercent(stats.rate(stats.classCount(4))),
+		formatPercent(stats.rate(stats.classCount(5))))
+	fmt.Println(lipgloss.NewStyle().Foreground(errorRateColor(stats.errorRate())).Render(errorLine))
+
+	if stats.Skipped > 0 {
+		skipped := lipgloss.NewStyle().
+			Foreground(redirectionColor).
+			Render(fmt.Sprintf("⚠️  Skipped:     %d lines not in a known log format", stats.Skipped))
+		fmt.Println(skipped)
+	}
+	if stats.NoResponse > 0 {
+		noResponse := lipgloss.NewStyle().
+			Foreground(mutedColor).
+			Render(fmt.Sprintf("📭 No response: %d requests ended before a status was sent (logged as 0)", stats.NoResponse))
+		fmt.Println(noResponse)
+	}
+	fmt.Println()
+}
+
+// errorRateColor colors an error rate green below 1%, orange below 5% and
+// red above
+func errorRateColor(rate float64) lipgloss.Color {
+	switch {
+	case rate < 0.01:
+		return successColor
+	case rate < 0.05:
+		return redirectionColor
+	default:
+		return clientErrorColor
+	}
+}
@@ -0,0 +1,186 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Sample access log in combined and common log format
+const sampleAccessLog = `192.168.1.10 - - [19/Oct/2026:10:00:01 +0000] "GET /users/42 HTTP/1.1" 200 512 "-" "curl/8.5.0"
+192.168.1.10 - - [19/Oct/2026:10:00:02 +0000] "GET /users/43 HTTP/1.1" 200 512 "-" "curl/8.5.0"
+192.168.1.11 - alice [19/Oct/2026:10:00:03 +0000] "POST /orders HTTP/1.1" 201 128 "-" "Mozilla/5.0"
+192.168.1.12 - - [19/Oct/2026:10:00:04 +0000] "GET /missing HTTP/1.1" 404 64
+192.168.1.13 - - [19/Oct/2026:10:01:05 +0000] "GET /api/slow HTTP/1.1" 504 0 "-" "Mozilla/5.0"
+
+not an access log line
+`
+
+func TestParseCombinedLogLine(t *testing.T) {
+	tests := []struct {
+		name       string
+		line       string
+		wantStatus int
+		wantMethod string
+		wantPath   string
+		wantOK     bool
+	}{
+		{
+			name:       "combined",
+			line:       `10.0.0.1 - - [19/Oct/2026:10:00:01 +0000] "GET /users/42?x=1 HTTP/1.1" 200 512 "https://example.com/" "curl/8.5.0"`,
+			wantStatus: 200,
+			wantMethod: "GET",
+			wantPath:   "/users/42?x=1",
+			wantOK:     true,
+		},
+		{
+			name:       "common",
+			line:       `10.0.0.1 - bob [19/Oct/2026:10:00:01 +0000] "DELETE /orders/7 HTTP/1.1" 204 -`,
+			wantStatus: 204,
+			wantMethod: "DELETE",
+			wantPath:   "/orders/7",
+			wantOK:     true,
+		},
+		{
+			name:       "escaped quote in request",
+			line:       `10.0.0.1 - - [19/Oct/2026:10:00:01 +0000] "GET /a\"b HTTP/1.1" 400 0 "-" "-"`,
+			wantStatus: 400,
+			wantMethod: "GET",
+			wantPath:   `/a\"b`,
+			wantOK:     true,
+		},
+		{
+			name:       "malformed request line",
+			line:       `10.0.0.1 - - [19/Oct/2026:10:00:01 +0000] "\x16\x03\x01" 400 157 "-" "-"`,
+			wantStatus: 400,
+			wantOK:     true,
+		},
+		{
+			name:   "not a log line",
+			line:   "Starting nginx 1.25.3",
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record, ok := parseCombinedLogLine(tt.line)
+			if ok != tt.wantOK {
+				t.Fatalf("parseCombinedLogLine() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if record.Status != tt.wantStatus || record.Method != tt.wantMethod || record.Path != tt.wantPath {
+				t.Errorf("parseCombinedLogLine() = %+v, want status %d %s %s", record, tt.wantStatus, tt.wantMethod, tt.wantPath)
+			}
+		})
+	}
+
+	record, _ := parseCombinedLogLine(`10.0.0.1 - - [19/Oct/2026:10:00:01 +0200] "GET / HTTP/1.1" 200 1`)
+	if want := time.Date(2026, time.October, 19, 8, 0, 1, 0, time.UTC); !record.Time.Equal(want) {
+		t.Errorf("Expected time %v, got %v", want, record.Time)
+	}
+}
+
+func TestCollectStatusStats(t *testing.T) {
+	stats := newStatusStats()
+	if err := collectStatusStats(strings.NewReader(sampleAccessLog), logFormatPresets, stats); err != nil {
+		t.Fatalf("collectStatusStats() error = %v", err)
+	}
+
+	if stats.Total != 5 || stats.Skipped != 1 {
+		t.Errorf("Expected 5 records and 1 skipped line, got %d and %d", stats.Total, stats.Skipped)
+	}
+	if stats.Counts[200] != 2 || stats.classCount(2) != 3 || stats.classCount(5) != 1 {
+		t.Errorf("Unexpected counts %v", stats.Counts)
+	}
+	if rate := stats.errorRate(); rate != 0.4 {
+		t.Errorf("Expected error rate 0.4, got %v", rate)
+	}
+}
+
+func TestHistogramBar(t *testing.T) {
+	tests := []struct {
+		count, largest, width int
+		want                  int
+	}{
+		{10, 10, 40, 40},
+		{5, 10, 40, 20},
+		{1, 1000, 40, 1},
+		{0, 10, 40, 0},
+	}
+
+	for _, tt := range tests {
+		if got := len([]rune(histogramBar(tt.count, tt.largest, tt.width))); got != tt.want {
+			t.Errorf("histogramBar(%d, %d, %d) has %d blocks, want %d", tt.count, tt.largest, tt.width, got, tt.want)
+		}
+	}
+}
+
+func TestStatsCommand(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "access.log")
+	if err := os.WriteFile(path, []byte(sampleAccessLog), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		args         []string
+		wantContains []string
+	}{
+		{
+			name: "file",
+			args: []string{"stats", path},
+			wantContains: []string{
+				"Status Codes in 5 Requests",
+				"200 OK",
+				"504 Gateway Timeout",
+				"40.0%",
+				"Error rate:  40.0% (4xx 20.0%, 5xx 20.0%)",
+				"Skipped:     1 lines",
+			},
+		},
+		{
+			name:         "missing file",
+			args:         []string{"stats", filepath.Join(t.TempDir(), "missing.log")},
+			wantContains: []string{"cannot read"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout, _ := captureOutput(func() {
+				rootCmd.SetArgs(tt.args)
+				rootCmd.Execute()
+			})
+
+			for _, want := range tt.wantContains {
+				if !strings.Contains(stdout, want) {
+					t.Errorf("Expected '%s' in output, got: %s", want, stdout)
+				}
+			}
+		})
+	}
+}
+
+func TestReadStatusStatsFromStdin(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		w.WriteString(sampleAccessLog)
+		w.Close()
+	}()
+
+	stats, err := readStatusStats(nil, r)
+	if err != nil {
+		t.Fatalf("readStatusStats() error = %v", err)
+	}
+	if stats.Total != 5 {
+		t.Errorf("Expected 5 records from stdin, got %d", stats.Total)
+	}
+}
@@ -0,0 +1,368 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// How often a followed log is checked for new lines
+	tailPollInterval = 250 * time.Millisecond
+	// Longest rolling window, in seconds
+	rollingWindowSeconds = 300
+	// Requests needed in the last minute before the 5xx alert can fire
+	tailAlertMinRequests = 20
+	// Width of the bars of the top codes
+	tailBarWidth = 20
+)
+
+var (
+	tailFollow      bool
+	tailInterval    time.Duration
+	tailAlert       float64
+	tailExitOnAlert bool
+	tailTop         int
+)
+
+// tailCmd represents the tail command
+var tailCmd = &cobra.Command{
+	Use:   "tail [-f] <file>",
+	Short: "Show a live error-rate dashboard for an access log",
+	Long: `Show a compact dashboard of an access log: requests per class, the top
+status codes and the error rates of the last 1 and 5 minutes.
+
+With -f the log is followed like tail -F, surviving rotation and truncation
+and waiting for a log that does not exist yet, and the dashboard is redrawn
+every --interval. Without -f the whole file, which may be compressed, is
+summarized once, with the rolling windows ending at its newest request.
+
+--alert rings the terminal bell when the 5xx rate of the last minute exceeds
+the given percentage (once at least 20 requests were seen in that minute),
+also in a summary; add --exit-on-alert to exit with status 1 instead, e.g.
+in a deploy script:
+  httpcode tail -f /var/log/nginx/access.log --alert 5 --exit-on-alert`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		alerted, err := runTail(ctx, args[0], os.Stdout, isTerminal(os.Stdout))
+		if err != nil {
+			displayErrorWithLipgloss(err.Error())
+			os.Exit(1)
+		}
+		if alerted && tailExitOnAlert {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	tailCmd.Flags().BoolVarP(&tailFollow, "follow", "f", false, "follow the log as it grows, across rotation and truncation")
+	tailCmd.Flags().StringVar(&logFormatFlag, "format", "auto", logFormatFlagUsage)
+	tailCmd.Flags().DurationVar(&tailInterval, "interval", time.Second, "how often the dashboard is redrawn")
+	tailCmd.Flags().Float64Var(&tailAlert, "alert", 0, "alert when the 5xx rate of the last minute exceeds this percentage")
+	tailCmd.Flags().BoolVar(&tailExitOnAlert, "exit-on-alert", false, "exit with status 1 on alert instead of ringing the bell")
+	tailCmd.Flags().IntVar(&tailTop, "top", 5, "number of top status codes shown")
+	rootCmd.AddCommand(tailCmd)
+}
+
+// rollingBucket counts the responses of one second, or of a whole window
+type rollingBucket struct {
+	Second       int64
+	Total        int
+	ClientErrors int
+	ServerErrors int
+}
+
+// rollingCounts counts responses per second over the longest rolling window
+type rollingCounts struct {
+	buckets [rollingWindowSeconds]rollingBucket
+}
+
+// add counts a response at a time
+func (c *rollingCounts) add(t time.Time, status int) {
+	second := t.Unix()
+	if second < 0 {
+		return
+	}
+	bucket := &c.buckets[second%rollingWindowSeconds]
+	if bucket.Second > second {
+		// Older than every window
+		return
+	}
+	if bucket.Second != second {
+		*bucket = rollingBucket{Second: second}
+	}
+	bucket.Total++
+	switch status / 100 {
+	case 4:
+		bucket.ClientErrors++
+	case 5:
+		bucket.ServerErrors++
+	}
+}
+
+// window sums the responses of the window of length d ending at now
+func (c *rollingCounts) window(now time.Time, d time.Duration) rollingBucket {
+	var sum rollingBucket
+	end := now.Unix()
+	start := end - int64(d/time.Second)
+	for _, bucket := range c.buckets {
+		if bucket.Second > start && bucket.Second <= end {
+			sum.Total += bucket.Total
+			sum.ClientErrors += bucket.ClientErrors
+			sum.ServerErrors += bucket.ServerErrors
+		}
+	}
+	return sum
+}
+
+// rate returns a count as a fraction of the window's responses
+func (b rollingBucket) rate(count int) float64 {
+	if b.Total == 0 {
+		return 0
+	}
+	return float64(count) / float64(b.Total)
+}
+
+// tailDashboard is the state of the tail dashboard
+type tailDashboard struct {
+	path   string
+	follow bool
+	parser streamLogParser
+	stats  *statusStats
+	window rollingCounts
+	newest time.Time
+}
+
+func newTailDashboard(path string, formats []logFormat, follow bool) *tailDashboard {
+	return &tailDashboard{
+		path:   path,
+		follow: follow,
+		parser: streamLogParser{formats: formats},
+		stats:  newStatusStats(),
+	}
+}
+
+// addLine counts one log line. A followed log is windowed by arrival time,
+// a summarized log by the time of its requests.
+func (d *tailDashboard) addLine(line string, arrival time.Time) {
+	if isLogComment(line) {
+		return
+	}
+	record, ok := d.parser.parse(line)
+	if !ok {
+		d.stats.Skipped++
+		return
+	}
+	d.stats.add(record)
+	if record.Status == 0 {
+		// No response to count in the rolling windows
+		return
+	}
+
+	t := arrival
+	if !d.follow && !record.Time.IsZero() {
+		t = record.Time
+	}
+	if t.After(d.newest) {
+		d.newest = t
+	}
+	d.window.add(t, record.Status)
+}
+
+// now returns the end of the rolling windows
+func (d *tailDashboard) now() time.Time {
+	if d.follow || d.newest.IsZero() {
+		return time.Now()
+	}
+	return d.newest
+}
+
+// alertExceeded reports whether the 5xx rate of the last minute exceeds the
+// --alert percentage
+func (d *tailDashboard) alertExceeded(now time.Time) bool {
+	minute := d.window.window(now, time.Minute)
+	return tailAlert > 0 &&
+		minute.Total >= tailAlertMinRequests &&
+		minute.rate(minute.ServerErrors)*100 > tailAlert
+}
+
+// topCodes returns the n most frequent status codes
+func (d *tailDashboard) topCodes(n int) []int {
+	var codes []int
+	for code := range d.stats.Counts {
+		codes = append(codes, code)
+	}
+	sort.Slice(codes, func(i, j int) bool {
+		ci, cj := d.stats.Counts[codes[i]], d.stats.Counts[codes[j]]
+		if ci != cj {
+			return ci > cj
+		}
+		return codes[i] < codes[j]
+	})
+	if len(codes) > n {
+		codes = codes[:n]
+	}
+	return codes
+}
+
+// render draws the dashboard for the windows ending at now
+func (d *tailDashboard) render(now time.Time) string {
+	var b strings.Builder
+
+	mode := "summary"
+	if d.follow {
+		mode = "following"
+	}
+	b.WriteString(lipgloss.NewStyle().
+		Bold(true).
+		Foreground(textColor).
+		Render(fmt.Sprintf("📡 %s (%s, %d requests)", d.path, mode, d.stats.Total)))
+	b.WriteString("\n\n")
+
+	var classes []string
+	for class := 1; class <= 5; class++ {
+		classes = append(classes, lipgloss.NewStyle().
+			Foreground(getStatusCodeColor(class*100)).
+			Render(fmt.Sprintf("%dxx %d", class, d.stats.classCount(class))))
+	}
+	fmt.Fprintf(&b, "📊 Classes:     %s\n", strings.Join(classes, "  "))
+
+	top := d.topCodes(tailTop)
+	if len(top) > 0 {
+		b.WriteString("🔝 Top codes:\n")
+		largest := d.stats.Counts[top[0]]
+		for _, code := range top {
+			count := d.stats.Counts[code]
+			row := lipgloss.NewStyle().
+				Foreground(getStatusCodeColor(code)).
+				Render(fmt.Sprintf("  %d %-24s %-*s", code, statusName(code), tailBarWidth, histogramBar(count, largest, tailBarWidth)))
+			fmt.Fprintf(&b, "%s %8d %6s\n", row, count, formatPercent(d.stats.rate(count)))
+		}
+	}
+
+	for _, w := range []struct {
+		label    string
+		duration time.Duration
+	}{{"Last 1m:", time.Minute}, {"Last 5m:", 5 * time.Minute}} {
+		sum := d.window.window(now, w.duration)
+		errorRate := sum.rate(sum.ClientErrors + sum.ServerErrors)
+		line := fmt.Sprintf("⏱️  %-13s%d requests, %s errors (4xx %s, 5xx %s)",
+			w.label, sum.Total, formatPercent(errorRate),
+			formatPercent(sum.rate(sum.ClientErrors)), formatPercent(sum.rate(sum.ServerErrors)))
+		b.WriteString(lipgloss.NewStyle().Foreground(errorRateColor(errorRate)).Render(line))
+		b.WriteString("\n")
+	}
+
+	if d.alertExceeded(now) {
+		minute := d.window.window(now, time.Minute)
+		alert := fmt.Sprintf("🚨 Alert:       5xx rate %s in the last minute exceeds %s",
+			formatPercent(minute.rate(minute.ServerErrors)), formatPercent(tailAlert/100))
+		b.WriteString(lipgloss.NewStyle().Bold(true).Foreground(clientErrorColor).Render(alert))
+		b.WriteString("\n")
+	}
+
+	if d.stats.Skipped > 0 {
+		b.WriteString(lipgloss.NewStyle().
+			Foreground(redirectionColor).
+			Render(fmt.Sprintf("⚠️  Skipped:     %d lines not in a known log format", d.stats.Skipped)))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// runTail summarizes or follows a log until ctx is done. It reports whether
+// the 5xx alert fired; with --exit-on-alert it returns on the first alert.
+func runTail(ctx context.Context, path string, out io.Writer, terminal bool) (bool, error) {
+	formats, err := selectLogFormats()
+	if err != nil {
+		return false, err
+	}
+	dashboard := newTailDashboard(path, formats, tailFollow)
+
+	if !tailFollow {
+		file, err := openLogFile(path, os.Stdin)
+		if err != nil {
+			return false, fmt.Errorf("cannot read %s: %v", path, err)
+		}
+		defer file.Close()
+
+		oversized, err := readLogLines(file, func(line string) {
+			dashboard.addLine(line, time.Now())
+		})
+		if err != nil {
+			return false, fmt.Errorf("cannot read %s: %v", path, err)
+		}
+		dashboard.stats.Skipped += oversized
+		now := dashboard.now()
+		fmt.Fprintln(out, dashboard.render(now))
+		exceeded := dashboard.alertExceeded(now)
+		if exceeded && !tailExitOnAlert {
+			fmt.Fprint(out, "\a")
+		}
+		return exceeded, nil
+	}
+
+	if tailInterval <= 0 {
+		return false, fmt.Errorf("invalid --interval %s. Use a positive duration, e.g. 1s", tailInterval)
+	}
+	follower, err := openLogFollower(path, false)
+	if err != nil {
+		return false, fmt.Errorf("cannot read %s: %v", path, err)
+	}
+	defer follower.Close()
+
+	poll := time.NewTicker(min(tailPollInterval, tailInterval))
+	defer poll.Stop()
+	redraw := time.NewTicker(tailInterval)
+	defer redraw.Stop()
+
+	alerted, alerting := false, false
+	draw := func() {
+		if terminal {
+			// Move home and clear the screen
+			fmt.Fprint(out, "\033[H\033[2J")
+		}
+		fmt.Fprintln(out, dashboard.render(time.Now()))
+	}
+	draw()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return alerted, nil
+		case <-poll.C:
+			oversized, err := follower.readLines(func(line string) {
+				dashboard.addLine(line, time.Now())
+			})
+			dashboard.stats.Skipped += oversized
+			if err != nil {
+				return alerted, fmt.Errorf("cannot read %s: %v", path, err)
+			}
+		case <-redraw.C:
+			draw()
+			exceeded := dashboard.alertExceeded(time.Now())
+			if exceeded && !alerting {
+				alerted = true
+				if tailExitOnAlert {
+					return true, nil
+				}
+				// Ring the bell once per alert, not on every redraw
+				fmt.Fprint(out, "\a")
+			}
+			alerting = exceeded
+		}
+	}
+}
@@ -0,0 +1,296 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRollingCounts(t *testing.T) {
+	now := time.Date(2026, 10, 19, 10, 10, 0, 0, time.UTC)
+	var counts rollingCounts
+	counts.add(now, 200)
+	counts.add(now.Add(-30*time.Second), 503)
+	counts.add(now.Add(-90*time.Second), 404)
+	counts.add(now.Add(-4*time.Minute), 500)
+	counts.add(now.Add(-10*time.Minute), 500)
+
+	minute := counts.window(now, time.Minute)
+	if minute.Total != 2 || minute.ServerErrors != 1 || minute.ClientErrors != 0 {
+		t.Errorf("1m window = %+v, want 2 requests with 1 5xx", minute)
+	}
+	five := counts.window(now, 5*time.Minute)
+	if five.Total != 4 || five.ServerErrors != 2 || five.ClientErrors != 1 {
+		t.Errorf("5m window = %+v, want 4 requests with 2 5xx and 1 4xx", five)
+	}
+}
+
+// appendLines appends lines to a file
+func appendLines(t *testing.T, path string, lines ...string) {
+	t.Helper()
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	if _, err := file.WriteString(strings.Join(lines, "")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLogFollower(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "access.log")
+	appendLines(t, path, "old\n")
+
+	follower, err := openLogFollower(path, false)
+	if err != nil {
+		t.Fatalf("openLogFollower() error = %v", err)
+	}
+	defer follower.Close()
+
+	var lines []string
+	read := func() {
+		t.Helper()
+		if _, err := follower.readLines(func(line string) { lines = append(lines, line) }); err != nil {
+			t.Fatalf("readLines() error = %v", err)
+		}
+	}
+
+	appendLines(t, path, "one\n", "tw")
+	read()
+	appendLines(t, path, "o\n")
+	read()
+	if want := []string{"one", "two"}; !reflect.DeepEqual(lines, want) {
+		t.Fatalf("After appending, lines = %q, want %q", lines, want)
+	}
+
+	// Rotation: the rest of the old file is read before the new one
+	lines = nil
+	appendLines(t, path, "three\n")
+	if err := os.Rename(path, path+".1"); err != nil {
+		t.Fatal(err)
+	}
+	appendLines(t, path, "four\n")
+	read()
+	if want := []string{"three", "four"}; !reflect.DeepEqual(lines, want) {
+		t.Fatalf("After rotation, lines = %q, want %q", lines, want)
+	}
+
+	// Truncation: the file is read again from its start
+	lines = nil
+	if err := os.Truncate(path, 0); err != nil {
+		t.Fatal(err)
+	}
+	read()
+	appendLines(t, path, "five\n")
+	read()
+	if want := []string{"five"}; !reflect.DeepEqual(lines, want) {
+		t.Fatalf("After truncation, lines = %q, want %q", lines, want)
+	}
+}
+
+func TestLogFollowerReadsRotatedFileLate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "access.log")
+	// The writer keeps its descriptor across the rename, like nginx until it
+	// reopens its logs
+	writer, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer writer.Close()
+
+	follower, err := openLogFollower(path, false)
+	if err != nil {
+		t.Fatalf("openLogFollower() error = %v", err)
+	}
+	defer follower.Close()
+
+	var lines []string
+	read := func() {
+		t.Helper()
+		if _, err := follower.readLines(func(line string) { lines = append(lines, line) }); err != nil {
+			t.Fatalf("readLines() error = %v", err)
+		}
+	}
+
+	if err := os.Rename(path, path+".1"); err != nil {
+		t.Fatal(err)
+	}
+	appendLines(t, path)
+	read()
+
+	if _, err := writer.WriteString("late-to-old\n"); err != nil {
+		t.Fatal(err)
+	}
+	appendLines(t, path, "new\n")
+	read()
+	if want := []string{"late-to-old", "new"}; !reflect.DeepEqual(lines, want) {
+		t.Fatalf("After a late write to the rotated file, lines = %q, want %q", lines, want)
+	}
+}
+
+func TestLogFollowerSkipsOversizedLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "access.log")
+	appendLines(t, path)
+
+	follower, err := openLogFollower(path, false)
+	if err != nil {
+		t.Fatalf("openLogFollower() error = %v", err)
+	}
+	defer follower.Close()
+
+	var lines []string
+	oversized := 0
+	read := func() {
+		t.Helper()
+		n, err := follower.readLines(func(line string) { lines = append(lines, line) })
+		if err != nil {
+			t.Fatalf("readLines() error = %v", err)
+		}
+		oversized += n
+	}
+
+	// A runaway line is dropped while it grows, not buffered
+	runaway := strings.Repeat("x", maxLogLineSize)
+	appendLines(t, path, "one\n", runaway)
+	read()
+	appendLines(t, path, runaway)
+	read()
+	if n := len(follower.current.partial); n > maxLogLineSize+2 {
+		t.Fatalf("Buffered %d bytes of an unterminated line", n)
+	}
+	appendLines(t, path, "\ntwo\n")
+	read()
+	if want := []string{"one", "two"}; !reflect.DeepEqual(lines, want) || oversized != 1 {
+		t.Fatalf("lines = %q and %d oversized, want %q and 1", lines, oversized, want)
+	}
+}
+
+func TestLogFollowerWaitsForFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "access.log")
+
+	follower, err := openLogFollower(path, false)
+	if err != nil {
+		t.Fatalf("openLogFollower() error = %v", err)
+	}
+	defer follower.Close()
+
+	var lines []string
+	read := func() {
+		t.Helper()
+		if _, err := follower.readLines(func(line string) { lines = append(lines, line) }); err != nil {
+			t.Fatalf("readLines() error = %v", err)
+		}
+	}
+
+	read()
+	if len(lines) != 0 {
+		t.Fatalf("Before the log exists, lines = %q, want none", lines)
+	}
+
+	// A log created after the start is read from its start
+	appendLines(t, path, "one\n", "two\n")
+	read()
+	if want := []string{"one", "two"}; !reflect.DeepEqual(lines, want) {
+		t.Fatalf("After creation, lines = %q, want %q", lines, want)
+	}
+}
+
+func TestTailCommand(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "access.log")
+	if err := os.WriteFile(path, []byte(sampleAccessLog), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	stdout, _ := captureOutput(func() {
+		rootCmd.SetArgs([]string{"tail", path})
+		rootCmd.Execute()
+	})
+
+	expected := []string{
+		"(summary, 5 requests)",
+		"2xx 3",
+		"5xx 1",
+		"Top codes:",
+		"200 OK",
+		// Windows end at the newest request, 10:01:05
+		"Last 1m:     1 requests, 100.0% errors (4xx 0.0%, 5xx 100.0%)",
+		"Last 5m:     5 requests, 40.0% errors",
+		"Skipped:     1 lines",
+	}
+	for _, e := range expected {
+		if !strings.Contains(stdout, e) {
+			t.Errorf("Expected '%s' in output, got: %s", e, stdout)
+		}
+	}
+}
+
+func TestRunTailSummaryAlert(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "access.log")
+	line := `10.0.0.1 - - [19/Oct/2026:10:00:01 +0000] "GET / HTTP/1.1" 502 0` + "\n"
+	appendLines(t, path, strings.Repeat(line, tailAlertMinRequests))
+
+	tailAlert = 5
+	defer func() { tailAlert, tailExitOnAlert = 0, false }()
+
+	tests := []struct {
+		name        string
+		exitOnAlert bool
+		wantBell    bool
+	}{
+		{name: "bell", wantBell: true},
+		{name: "exit on alert", exitOnAlert: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tailExitOnAlert = tt.exitOnAlert
+			var out bytes.Buffer
+			alerted, err := runTail(context.Background(), path, &out, false)
+			if err != nil {
+				t.Fatalf("runTail() error = %v", err)
+			}
+			if !alerted {
+				t.Errorf("Expected the 5xx alert to fire, got: %s", out.String())
+			}
+			if bell := strings.Contains(out.String(), "\a"); bell != tt.wantBell {
+				t.Errorf("Bell rung = %v, want %v", bell, tt.wantBell)
+			}
+		})
+	}
+}
+
+func TestRunTailFollowAlert(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "access.log")
+	appendLines(t, path, "")
+
+	tailFollow, tailInterval, tailAlert, tailExitOnAlert = true, 50*time.Millisecond, 5, true
+	defer func() {
+		tailFollow, tailInterval, tailAlert, tailExitOnAlert = false, time.Second, 0, false
+	}()
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		line := `10.0.0.1 - - [19/Oct/2026:10:00:01 +0000] "GET / HTTP/1.1" 502 0` + "\n"
+		appendLines(t, path, strings.Repeat(line, tailAlertMinRequests))
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var out bytes.Buffer
+	alerted, err := runTail(ctx, path, &out, false)
+	if err != nil {
+		t.Fatalf("runTail() error = %v", err)
+	}
+	if !alerted {
+		t.Errorf("Expected the 5xx alert to fire, got: %s", out.String())
+	}
+	if !strings.Contains(out.String(), "5xx rate 100.0% in the last minute exceeds 5.0%") {
+		t.Errorf("Expected the alert in output, got: %s", out.String())
+	}
+}
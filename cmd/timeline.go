@@ -0,0 +1,363 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"golang.org/x/term"
+)
+
+var (
+	// Bucket size of the status timeline, set with --bucket
+	statsBucket time.Duration
+	// Output format of stats, set with --output
+	statsOutput string
+)
+
+// Width used when the terminal width is unknown, e.g. when piped
+const defaultTerminalWidth = 80
+
+// Narrowest bar or sparkline drawn, however narrow the terminal
+const minChartWidth = 10
+
+// Most buckets in a timeline, so that a few skewed timestamps do not stretch
+// it over years of empty buckets
+const maxTimelineBuckets = 1440
+
+// Sparkline levels, from the lowest non-zero count to the peak
+var sparklineLevels = []rune("▁▂▃▄▅▆▇█")
+
+// terminalWidth returns the width of the terminal, from $COLUMNS or the
+// terminal itself
+func terminalWidth(f *os.File) int {
+	if columns, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && columns > 0 {
+		return columns
+	}
+	if width, _, err := term.GetSize(int(f.Fd())); err == nil && width > 0 {
+		return width
+	}
+	return defaultTerminalWidth
+}
+
+// formatBucketSize formats a bucket size without zero units: 1m, 5m, 1h
+func formatBucketSize(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
+
+// bucketStarts returns the start of every bucket from the first to the last,
+// including empty ones, so gaps show in the timeline. Beyond
+// maxTimelineBuckets only the span with the most requests is kept; it also
+// returns the number of requests left out.
+func (s *statusStats) bucketStarts() ([]int64, int) {
+	if len(s.Buckets) == 0 || s.BucketSize <= 0 {
+		return nil, 0
+	}
+	step := int64(s.BucketSize / time.Second)
+	if step < 1 {
+		step = 1
+	}
+
+	var used []int64
+	totals := make(map[int64]int)
+	all := 0
+	for start, counts := range s.Buckets {
+		used = append(used, start)
+		for _, count := range counts {
+			totals[start] += count
+			all += count
+		}
+	}
+	sort.Slice(used, func(i, j int) bool { return used[i] < used[j] })
+
+	// Slide a window of maxTimelineBuckets over the used buckets
+	first, last, best := used[0], used[0], -1
+	window, left := 0, 0
+	for _, start := range used {
+		window += totals[start]
+		for start-used[left] >= maxTimelineBuckets*step {
+			window -= totals[used[left]]
+			left++
+		}
+		if window > best {
+			first, last, best = used[left], start, window
+		}
+	}
+
+	var starts []int64
+	for start := first; start <= last; start += step {
+		starts = append(starts, start)
+	}
+	return starts, all - best
+}
+
+// bucketLabel labels a bucket by its local start time, with the date when
+// the timeline spans several days
+func bucketLabel(start int64, multiDay bool) string {
+	t := time.Unix(start, 0)
+	if multiDay {
+		return t.Format("01-02 15:04")
+	}
+	return t.Format("15:04")
+}
+
+// spansDays reports whether bucket starts fall on different local days
+func spansDays(starts []int64) bool {
+	if len(starts) == 0 {
+		return false
+	}
+	first := time.Unix(starts[0], 0).Format("2006-01-02")
+	return time.Unix(starts[len(starts)-1], 0).Format("2006-01-02") != first
+}
+
+// classCounts sums status counts per class, indexed 1 to 5
+func classCounts(counts map[int]int) [6]int {
+	var classes [6]int
+	for code, count := range counts {
+		if class := code / 100; class >= 1 && class <= 5 {
+			classes[class] += count
+		}
+	}
+	return classes
+}
+
+// stackedBar draws a bar of per-class segments in the class colors, scaled
+// so that largest fills width. Every class present gets at least one cell,
+// taken from the largest segment. It returns the bar and its width in cells.
+func stackedBar(counts map[int]int, largest, width int) (string, int) {
+	if largest == 0 {
+		return "", 0
+	}
+	classes := classCounts(counts)
+	var blocks [6]int
+	cumulative, drawn, present := 0, 0, 0
+	for class := 1; class <= 5; class++ {
+		if classes[class] == 0 {
+			continue
+		}
+		cumulative += classes[class]
+		end := int(math.Round(float64(cumulative) / float64(largest) * float64(width)))
+		blocks[class] = max(end-drawn, 1)
+		drawn += blocks[class]
+		present++
+	}
+
+	// Give back the cells forced for tiny classes
+	length := max(int(math.Round(float64(cumulative)/float64(largest)*float64(width))), present)
+	for drawn > length {
+		widest := 1
+		for class := 2; class <= 5; class++ {
+			if blocks[class] > blocks[widest] {
+				widest = class
+			}
+		}
+		if blocks[widest] <= 1 {
+			break
+		}
+		blocks[widest]--
+		drawn--
+	}
+
+	var b strings.Builder
+	for class := 1; class <= 5; class++ {
+		if blocks[class] > 0 {
+			b.WriteString(lipgloss.NewStyle().
+				Foreground(getStatusCodeColor(class * 100)).
+				Render(strings.Repeat("█", blocks[class])))
+		}
+	}
+	return b.String(), drawn
+}
+
+// sparkline draws one cell per count, blank for zero and scaled to the peak
+func sparkline(counts []int) string {
+	peak := 0
+	for _, count := range counts {
+		peak = max(peak, count)
+	}
+	var b strings.Builder
+	for _, count := range counts {
+		if count == 0 || peak == 0 {
+			b.WriteRune(' ')
+			continue
+		}
+		level := int(math.Ceil(float64(count)/float64(peak)*float64(len(sparklineLevels)))) - 1
+		b.WriteRune(sparklineLevels[max(level, 0)])
+	}
+	return b.String()
+}
+
+func displayStatusTimelineWithLipgloss(stats *statusStats, width int) {
+	starts, outliers := stats.bucketStarts()
+	if len(starts) == 0 {
+		displayErrorWithLipgloss("No timestamps found for the timeline")
+		return
+	}
+	multiDay := spansDays(starts)
+	labelWidth := len(bucketLabel(starts[0], multiDay))
+
+	largest := 0
+	for _, start := range starts {
+		total := 0
+		for _, count := range stats.Buckets[start] {
+			total += count
+		}
+		largest = max(largest, total)
+	}
+
+	displayListHeaderWithLipgloss(fmt.Sprintf("Status Classes per %s", formatBucketSize(stats.BucketSize)))
+
+	// "  label bar total errors"
+	barWidth := max(width-2-labelWidth-1-1-8-1-7, minChartWidth)
+	for _, start := range starts {
+		counts := stats.Buckets[start]
+		total := 0
+		for _, count := range counts {
+			total += count
+		}
+		classes := classCounts(counts)
+		bar, drawn := stackedBar(counts, largest, barWidth)
+
+		errors := ""
+		if total > 0 {
+			rate := float64(classes[4]+classes[5]) / float64(total)
+			errors = " " + lipgloss.NewStyle().Foreground(errorRateColor(rate)).Render(fmt.Sprintf("%7s", formatPercent(rate)))
+		}
+		fmt.Printf("  %-*s %s%s %8d%s\n", labelWidth, bucketLabel(start, multiDay), bar, strings.Repeat(" ", max(barWidth-drawn, 0)), total, errors)
+	}
+
+	var legend []string
+	for class := 1; class <= 5; class++ {
+		legend = append(legend, lipgloss.NewStyle().
+			Foreground(getStatusCodeColor(class*100)).
+			Render(fmt.Sprintf("█ %dxx", class)))
+	}
+	fmt.Printf("  %s  (right: requests, error rate)\n", strings.Join(legend, "  "))
+	fmt.Println()
+
+	// One sparkline per code; the most recent buckets when they do not fit
+	sparkWidth := max(width-2-4-25-1-14, minChartWidth)
+	shown := starts
+	if len(shown) > sparkWidth {
+		shown = shown[len(shown)-sparkWidth:]
+	}
+	title := fmt.Sprintf("Status Codes per %s", formatBucketSize(stats.BucketSize))
+	if len(shown) < len(starts) {
+		title += fmt.Sprintf(" (last %d buckets)", len(shown))
+	}
+	displayListHeaderWithLipgloss(title)
+
+	var codes []int
+	for code := range stats.Counts {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+	for _, code := range codes {
+		counts := make([]int, len(shown))
+		first := int64(-1)
+		for i, start := range shown {
+			counts[i] = stats.Buckets[start][code]
+		}
+		for _, start := range starts {
+			if stats.Buckets[start][code] > 0 {
+				first = start
+				break
+			}
+		}
+		if first < 0 {
+			continue
+		}
+		row := lipgloss.NewStyle().
+			Foreground(getStatusCodeColor(code)).
+			Render(fmt.Sprintf("  %d %-25s %s", code, truncateText(statusName(code), 25), sparkline(counts)))
+		fmt.Printf("%s %s\n", row, lipgloss.NewStyle().Foreground(mutedColor).Render("from "+bucketLabel(first, multiDay)))
+	}
+
+	if stats.Undated > 0 {
+		undated := lipgloss.NewStyle().
+			Foreground(redirectionColor).
+			Render(fmt.Sprintf("⚠️  Undated:     %d requests without a timestamp are not in the timeline", stats.Undated))
+		fmt.Println(undated)
+	}
+	if outliers > 0 {
+		fmt.Println(lipgloss.NewStyle().
+			Foreground(redirectionColor).
+			Render(fmt.Sprintf("⚠️  Outliers:    %d requests outside the busiest %d buckets are not in the timeline", outliers, maxTimelineBuckets)))
+	}
+	fmt.Println()
+}
+
+// truncateText shortens text to width characters, ending with an ellipsis
+func truncateText(text string, width int) string {
+	runes := []rune(text)
+	if len(runes) <= width {
+		return text
+	}
+	return string(runes[:width-1]) + "…"
+}
+
+// writeStatusStatsCSV writes the status counts as CSV: one row per code, or
+// one row per bucket with a column per class and per code when bucketed
+func writeStatusStatsCSV(w io.Writer, stats *statusStats) error {
+	var codes []int
+	for code := range stats.Counts {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+
+	out := csv.NewWriter(w)
+	if stats.BucketSize <= 0 {
+		out.Write([]string{"code", "description", "count", "percent"})
+		for _, code := range codes {
+			count := stats.Counts[code]
+			out.Write([]string{
+				strconv.Itoa(code),
+				statusName(code),
+				strconv.Itoa(count),
+				strconv.FormatFloat(stats.rate(count)*100, 'f', 2, 64),
+			})
+		}
+	} else {
+		header := []string{"time", "total", "1xx", "2xx", "3xx", "4xx", "5xx"}
+		for _, code := range codes {
+			header = append(header, strconv.Itoa(code))
+		}
+		out.Write(header)
+		starts, outliers := stats.bucketStarts()
+		if outliers > 0 {
+			fmt.Fprintf(os.Stderr, "%d requests outside the busiest %d buckets are not in the CSV\n", outliers, maxTimelineBuckets)
+		}
+		for _, start := range starts {
+			counts := stats.Buckets[start]
+			classes := classCounts(counts)
+			total := 0
+			for _, count := range counts {
+				total += count
+			}
+			row := []string{time.Unix(start, 0).UTC().Format(time.RFC3339), strconv.Itoa(total)}
+			for class := 1; class <= 5; class++ {
+				row = append(row, strconv.Itoa(classes[class]))
+			}
+			for _, code := range codes {
+				row = append(row, strconv.Itoa(counts[code]))
+			}
+			out.Write(row)
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Sample access log where 502s start at 10:03
+const sampleTimelineLog = `10.0.0.1 - - [19/Oct/2026:10:00:01 +0000] "GET / HTTP/1.1" 200 1
+10.0.0.1 - - [19/Oct/2026:10:00:11 +0000] "GET / HTTP/1.1" 200 1
+10.0.0.1 - - [19/Oct/2026:10:01:01 +0000] "GET / HTTP/1.1" 200 1
+10.0.0.1 - - [19/Oct/2026:10:01:01 +0000] "GET /x HTTP/1.1" 404 1
+10.0.0.1 - - [19/Oct/2026:10:03:01 +0000] "GET / HTTP/1.1" 502 1
+10.0.0.1 - - [19/Oct/2026:10:03:02 +0000] "GET / HTTP/1.1" 502 1
+10.0.0.1 - - [19/Oct/2026:10:03:03 +0000] "GET / HTTP/1.1" 200 1
+10.0.0.1 - - [19/Oct/2026:10:04:03 +0000] "GET / HTTP/1.1" 502 1
+`
+
+func TestSparkline(t *testing.T) {
+	tests := []struct {
+		counts []int
+		want   string
+	}{
+		{counts: []int{0, 1, 2, 4, 8}, want: " ▁▂▄█"},
+		{counts: []int{0, 0}, want: "  "},
+		{counts: []int{1000, 1}, want: "█▁"},
+	}
+
+	for _, tt := range tests {
+		if got := sparkline(tt.counts); got != tt.want {
+			t.Errorf("sparkline(%v) = %q, want %q", tt.counts, got, tt.want)
+		}
+	}
+}
+
+func TestStackedBar(t *testing.T) {
+	tests := []struct {
+		name      string
+		counts    map[int]int
+		largest   int
+		width     int
+		wantWidth int
+	}{
+		{name: "largest bucket fills the width", counts: map[int]int{200: 6, 502: 4}, largest: 10, width: 20, wantWidth: 20},
+		{name: "half as many requests", counts: map[int]int{200: 5}, largest: 10, width: 20, wantWidth: 10},
+		{name: "tiny classes still show", counts: map[int]int{200: 1000, 404: 1}, largest: 1001, width: 20, wantWidth: 20},
+		{name: "every class tiny but one", counts: map[int]int{100: 1, 200: 1000, 301: 1, 404: 1, 502: 1}, largest: 1004, width: 20, wantWidth: 20},
+		{name: "empty", counts: map[int]int{}, largest: 0, width: 20, wantWidth: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bar, drawn := stackedBar(tt.counts, tt.largest, tt.width)
+			if drawn != tt.wantWidth || strings.Count(bar, "█") != tt.wantWidth {
+				t.Errorf("stackedBar() drew %d blocks (%q), want %d", drawn, bar, tt.wantWidth)
+			}
+		})
+	}
+}
+
+func TestBucketStarts(t *testing.T) {
+	stats := newStatusStats()
+	stats.BucketSize = time.Minute
+	base := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	stats.add(logRecord{Time: base.Add(30 * time.Second), Status: 200})
+	stats.add(logRecord{Time: base.Add(3 * time.Minute), Status: 502})
+	stats.add(logRecord{Status: 200})
+
+	want := []int64{base.Unix(), base.Unix() + 60, base.Unix() + 120, base.Unix() + 180}
+	if got, outliers := stats.bucketStarts(); !reflect.DeepEqual(got, want) || outliers != 0 {
+		t.Errorf("bucketStarts() = %v, %d, want %v, 0", got, outliers, want)
+	}
+	if stats.Undated != 1 {
+		t.Errorf("Expected 1 undated record, got %d", stats.Undated)
+	}
+}
+
+func TestBucketStartsSkewedTimestamps(t *testing.T) {
+	stats := newStatusStats()
+	stats.BucketSize = time.Minute
+	base := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	for i := 0; i < 10; i++ {
+		stats.add(logRecord{Time: base.Add(time.Duration(i) * time.Minute), Status: 200})
+	}
+	// A garbage epoch timestamp and a line from last year
+	stats.add(logRecord{Time: time.Unix(0, 0), Status: 200})
+	stats.add(logRecord{Time: base.AddDate(-1, 0, 0), Status: 500})
+
+	starts, outliers := stats.bucketStarts()
+	if len(starts) != 10 || starts[0] != base.Unix() || outliers != 2 {
+		t.Errorf("bucketStarts() = %d buckets from %d, %d outliers, want 10 from %d, 2 outliers", len(starts), starts[0], outliers, base.Unix())
+	}
+}
+
+func TestFormatBucketSize(t *testing.T) {
+	tests := map[time.Duration]string{
+		time.Minute:      "1m",
+		5 * time.Minute:  "5m",
+		time.Hour:        "1h",
+		90 * time.Minute: "1h30m",
+		30 * time.Second: "30s",
+	}
+	for d, want := range tests {
+		if got := formatBucketSize(d); got != want {
+			t.Errorf("formatBucketSize(%s) = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestTerminalWidthFromColumns(t *testing.T) {
+	t.Setenv("COLUMNS", "132")
+	if got := terminalWidth(os.Stdout); got != 132 {
+		t.Errorf("terminalWidth() = %d, want 132", got)
+	}
+}
+
+func TestStatsTimeline(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "access.log")
+	if err := os.WriteFile(path, []byte(sampleTimelineLog), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	local := time.Local
+	time.Local = time.UTC
+	t.Setenv("COLUMNS", "90")
+	defer func() {
+		time.Local = local
+		statsBucket, statsOutput, statsEndpoints = 0, "text", 0
+	}()
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected []string
+	}{
+		{
+			name: "chart",
+			args: []string{"stats", "--bucket", "1m", path},
+			expected: []string{
+				"Status Classes per 1m",
+				"10:02",
+				"10:03 ",
+				"66.7%",
+				"Status Codes per 1m",
+				"502 Bad Gateway                  █▄ from 10:03",
+			},
+		},
+		{
+			name: "bucketed csv",
+			args: []string{"stats", "--bucket", "1m", "-o", "csv", path},
+			expected: []string{
+				"time,total,1xx,2xx,3xx,4xx,5xx,200,404,502",
+				"2026-10-19T10:02:00Z,0,0,0,0,0,0,0,0,0",
+				"2026-10-19T10:03:00Z,3,0,1,0,0,2,1,0,2",
+			},
+		},
+		{
+			name:     "code csv",
+			args:     []string{"stats", "--bucket", "0", "-o", "csv", path},
+			expected: []string{"code,description,count,percent", "502,Bad Gateway,3,37.50"},
+		},
+		{
+			name:     "invalid output",
+			args:     []string{"stats", "-o", "xml", path},
+			expected: []string{"Invalid output xml. Use text or csv"},
+		},
+		{
+			name:     "csv of buckets and endpoints",
+			args:     []string{"stats", "--bucket", "1m", "--endpoints", "5", "-o", "csv", path},
+			expected: []string{"-o csv writes one table. Use either --bucket or --endpoints"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statsBucket, statsOutput, statsEndpoints = 0, "text", 0
+			stdout, _ := captureOutput(func() {
+				rootCmd.SetArgs(tt.args)
+				rootCmd.Execute()
+			})
+			for _, expected := range tt.expected {
+				if !strings.Contains(stdout, expected) {
+					t.Errorf("Expected '%s' in output, got: %s", expected, stdout)
+				}
+			}
+		})
+	}
+}
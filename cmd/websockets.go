@@ -0,0 +1,115 @@
+package cmd
+
+import "fmt"
+
+// Kinds of WebSocket close codes
+const (
+	wsKindNormal        = "Normal"
+	wsKindProtocolError = "Protocol Error"
+	wsKindReserved      = "Reserved"
+	wsKindRegistered    = "Registered"
+	wsKindPrivate       = "Private Use"
+)
+
+// WebSocketCloseCodeInfo contains reference information about a WebSocket close code
+type WebSocketCloseCodeInfo struct {
+	Code        int
+	Name        string
+	Kind        string
+	Description string
+	Spec        string
+	// Whether an endpoint may send the code in a Close frame
+	Sendable bool
+}
+
+const wsCloseCodeDocsLink = "https://developer.mozilla.org/en-US/docs/Web/API/CloseEvent/code"
+
+// WebSocket close codes from the IANA WebSocket Close Code Number Registry
+var webSocketCloseCodesInfo = map[int]WebSocketCloseCodeInfo{
+	1000: {1000, "Normal Closure", wsKindNormal,
+		"The purpose for which the connection was established has been fulfilled.",
+		"RFC 6455, Section 7.4.1", true},
+	1001: {1001, "Going Away", wsKindNormal,
+		"An endpoint is going away, such as a server going down or a browser navigating away from the page.",
+		"RFC 6455, Section 7.4.1", true},
+	1002: {1002, "Protocol Error", wsKindProtocolError,
+		"An endpoint is terminating the connection due to a protocol error.",
+		"RFC 6455, Section 7.4.1", true},
+	1003: {1003, "Unsupported Data", wsKindProtocolError,
+		"An endpoint received a type of data it cannot accept, e.g. a text-only endpoint received a binary message.",
+		"RFC 6455, Section 7.4.1", true},
+	1004: {1004, "Reserved", wsKindReserved,
+		"Reserved. A meaning might be defined in the future.",
+		"RFC 6455, Section 7.4.1", false},
+	1005: {1005, "No Status Received", wsKindReserved,
+		"Reported by the API when a Close frame arrived without a status code. Must not be sent in a Close frame.",
+		"RFC 6455, Section 7.4.1", false},
+	1006: {1006, "Abnormal Closure", wsKindReserved,
+		"Reported by the API when the connection closed without a Close frame, e.g. the TCP connection dropped or a proxy timed out. Must not be sent in a Close frame.",
+		"RFC 6455, Section 7.4.1", false},
+	1007: {1007, "Invalid Frame Payload Data", wsKindProtocolError,
+		"An endpoint received data inconsistent with the message type, e.g. non-UTF-8 data in a text message.",
+		"RFC 6455, Section 7.4.1", true},
+	1008: {1008, "Policy Violation", wsKindProtocolError,
+		"An endpoint received a message that violates its policy. Used when no more specific code applies.",
+		"RFC 6455, Section 7.4.1", true},
+	1009: {1009, "Message Too Big", wsKindProtocolError,
+		"An endpoint received a message that is too big for it to process.",
+		"RFC 6455, Section 7.4.1", true},
+	1010: {1010, "Mandatory Extension", wsKindProtocolError,
+		"The client expected the server to negotiate one or more extensions, but the server did not.",
+		"RFC 6455, Section 7.4.1", true},
+	1011: {1011, "Internal Error", wsKindProtocolError,
+		"The server encountered an unexpected condition that prevented it from fulfilling the request.",
+		"RFC 6455, Section 7.4.1", true},
+	1012: {1012, "Service Restart", wsKindNormal,
+		"The server is restarting. A client may reconnect, ideally after a randomized delay.",
+		"IANA WebSocket Close Code Number Registry", true},
+	1013: {1013, "Try Again Later", wsKindNormal,
+		"The server is overloaded or otherwise temporarily unable to serve the client. A client may reconnect later.",
+		"IANA WebSocket Close Code Number Registry", true},
+	1014: {1014, "Bad Gateway", wsKindProtocolError,
+		"The server was acting as a gateway or proxy and received an invalid response from the upstream server.",
+		"IANA WebSocket Close Code Number Registry", true},
+	1015: {1015, "TLS Handshake", wsKindReserved,
+		"Reported by the API when the connection closed because the TLS handshake failed. Must not be sent in a Close frame.",
+		"RFC 6455, Section 7.4.1", false},
+	3000: {3000, "Unauthorized", wsKindRegistered,
+		"The endpoint is unauthorized, the WebSocket equivalent of HTTP 401.",
+		"IANA WebSocket Close Code Number Registry", true},
+	3003: {3003, "Forbidden", wsKindRegistered,
+		"The endpoint is authenticated but not allowed to access the resource, the WebSocket equivalent of HTTP 403.",
+		"IANA WebSocket Close Code Number Registry", true},
+	3008: {3008, "Timeout", wsKindRegistered,
+		"The endpoint took too long to respond, the WebSocket equivalent of HTTP 408.",
+		"IANA WebSocket Close Code Number Registry", true},
+}
+
+// findWebSocketCloseCode returns the registry entry for a close code, or a
+// description of the range it falls in when it has no entry of its own
+func findWebSocketCloseCode(code int) (WebSocketCloseCodeInfo, bool) {
+	if info, exists := webSocketCloseCodesInfo[code]; exists {
+		return info, true
+	}
+
+	switch {
+	case code >= 1000 && code <= 2999:
+		return WebSocketCloseCodeInfo{code, "Unassigned", wsKindReserved,
+			"Reserved for future revisions of the WebSocket protocol and its extensions.",
+			"RFC 6455, Section 7.4.2", false}, true
+	case code >= 3000 && code <= 3999:
+		return WebSocketCloseCodeInfo{code, "Unassigned", wsKindRegistered,
+			"Reserved for libraries, frameworks and applications; codes are registered with IANA on a first-come, first-served basis.",
+			"RFC 6455, Section 7.4.2", true}, true
+	case code >= 4000 && code <= 4999:
+		return WebSocketCloseCodeInfo{code, "Application Defined", wsKindPrivate,
+			"Reserved for private use; the meaning is agreed between the application's client and server.",
+			"RFC 6455, Section 7.4.2", true}, true
+	}
+	return WebSocketCloseCodeInfo{}, false
+}
+
+// formatWebSocketCloseCode formats a close code as "1006 Abnormal Closure"
+func formatWebSocketCloseCode(info WebSocketCloseCodeInfo) string {
+	return fmt.Sprintf("%d %s", info.Code, info.Name)
+}
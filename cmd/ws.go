@@ -0,0 +1,214 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+// Colors used for WebSocket close code kinds
+var wsKindColors = map[string]lipgloss.Color{
+	wsKindNormal:        successColor,
+	wsKindProtocolError: clientErrorColor,
+	wsKindReserved:      unknownColor,
+	wsKindRegistered:    informationalColor,
+	wsKindPrivate:       serverErrorColor,
+}
+
+// Order of kinds in the close code list
+var wsKindOrder = []string{wsKindNormal, wsKindProtocolError, wsKindReserved, wsKindRegistered}
+
+// wsCmd represents the ws command
+var wsCmd = &cobra.Command{
+	Use:   "ws [code]",
+	Short: "Look up WebSocket close codes",
+	Long: `Look up WebSocket close codes (RFC 6455, Section 7.4): the 1000-1015 codes
+defined by the protocol, the 3000-3999 range registered with IANA and the
+4000-4999 range reserved for private use.
+
+Running 'httpcode ws' without arguments launches the interactive fuzzy search.`,
+	Args: cobra.ArbitraryArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 {
+			runWebSocketFzfSearch()
+			return
+		}
+		lookupWebSocketCloseCode(args[0])
+	},
+}
+
+// wsListCmd represents the ws list command
+var wsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List WebSocket close codes",
+	Long:  `List all registered WebSocket close codes grouped by kind.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		listWebSocketCloseCodes()
+	},
+}
+
+// wsSearchCmd represents the ws search command
+var wsSearchCmd = &cobra.Command{
+	Use:   "search",
+	Short: "Interactive fuzzy search for WebSocket close codes",
+	Long:  `Use fuzzy search to interactively search for WebSocket close codes with detailed preview.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		runWebSocketFzfSearch()
+	},
+}
+
+func init() {
+	wsCmd.AddCommand(wsListCmd)
+	wsCmd.AddCommand(wsSearchCmd)
+	rootCmd.AddCommand(wsCmd)
+}
+
+// lookupWebSocketCloseCode looks up a WebSocket close code
+func lookupWebSocketCloseCode(arg string) {
+	code, err := strconv.Atoi(arg)
+	if err != nil {
+		displayErrorWithLipgloss(fmt.Sprintf("invalid WebSocket close code %s", arg))
+		return
+	}
+
+	if info, exists := findWebSocketCloseCode(code); exists {
+		displayWebSocketCloseCodeWithLipgloss(info)
+	} else if code >= 0 && code <= 999 {
+		displayErrorWithLipgloss(fmt.Sprintf("WebSocket close code %d is not used; close codes start at 1000", code))
+	} else {
+		displayErrorWithLipgloss(fmt.Sprintf("WebSocket close code %d not found", code))
+	}
+}
+
+// sortedWebSocketCloseCodes returns the registered close codes in ascending order
+func sortedWebSocketCloseCodes() []int {
+	var codes []int
+	for code := range webSocketCloseCodesInfo {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+	return codes
+}
+
+func listWebSocketCloseCodes() {
+	displayListHeaderWithLipgloss("WebSocket Close Codes")
+
+	codes := sortedWebSocketCloseCodes()
+	for _, kind := range wsKindOrder {
+		displayWebSocketKindWithLipgloss(kind)
+		for _, code := range codes {
+			info := webSocketCloseCodesInfo[code]
+			if info.Kind == kind {
+				displayWebSocketListItemWithLipgloss(info)
+			}
+		}
+	}
+
+	// Private use codes have no registry entries, so only the range is listed
+	displayWebSocketKindWithLipgloss(wsKindPrivate)
+	private := lipgloss.NewStyle().
+		Foreground(wsKindColors[wsKindPrivate]).
+		Render("  4000-4999")
+	fmt.Println(private + " Application Defined")
+}
+
+func runWebSocketFzfSearch() {
+	// Prepare data for fuzzy search
+	var items []string
+	var codeMap = make(map[string]int)
+
+	for _, code := range sortedWebSocketCloseCodes() {
+		info := webSocketCloseCodesInfo[code]
+
+		sendable := "yes"
+		if !info.Sendable {
+			sendable = "no (reported by the API only)"
+		}
+
+		item := fmt.Sprintf("%d\t%s\t%s\t%s\t%s\t%s",
+			info.Code,
+			escapeString(info.Name),
+			info.Kind,
+			escapeString(info.Description),
+			escapeString(info.Spec),
+			sendable)
+
+		items = append(items, item)
+		codeMap[item] = code
+	}
+
+	previewCmd := "echo -e '\\033[1;32mClose Code:\\033[0m  {1} {2}\\n" +
+		"\\033[1;32mKind:\\033[0m        {3}\\n" +
+		"\\033[1;32mDescription:\\033[0m\\n{4}\\n" +
+		"\\033[1;32mSpec:\\033[0m        {5}\\n" +
+		"\\033[1;32mSendable:\\033[0m    {6}'"
+
+	selection, ok := runFzfPicker(fzfPicker{
+		Items:   items,
+		Header:  "Code    Name          (Press ESC to exit, Enter to select)",
+		Label:   "httpcode - WebSocket Close Code Viewer",
+		Preview: previewCmd,
+	})
+	if !ok {
+		return
+	}
+
+	if code, exists := codeMap[selection]; exists {
+		displayWebSocketCloseCodeWithLipgloss(webSocketCloseCodesInfo[code])
+	} else {
+		fmt.Println(selection)
+	}
+}
+
+// displayWebSocketCloseCodeWithLipgloss displays WebSocket close code information using Lipgloss styling
+func displayWebSocketCloseCodeWithLipgloss(info WebSocketCloseCodeInfo) {
+	color := wsKindColors[info.Kind]
+
+	header := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(color).
+		Render(fmt.Sprintf("           WebSocket %s", formatWebSocketCloseCode(info)))
+	fmt.Println(header)
+
+	kind := lipgloss.NewStyle().
+		Foreground(color).
+		Render(fmt.Sprintf("📋 Kind:        %s", info.Kind))
+	fmt.Println(kind)
+
+	fmt.Printf("📝 Description: %s\n", info.Description)
+	fmt.Printf("📜 Spec:        %s\n", info.Spec)
+
+	if !info.Sendable {
+		warning := lipgloss.NewStyle().
+			Foreground(redirectionColor).
+			Render("⚠️  Sendable:    no, endpoints must not send this code in a Close frame")
+		fmt.Println(warning)
+	}
+
+	link := lipgloss.NewStyle().
+		Foreground(linkColor).
+		Render(fmt.Sprintf("🔗 Docs:        %s", wsCloseCodeDocsLink))
+	fmt.Println(link)
+
+	fmt.Println()
+}
+
+// displayWebSocketKindWithLipgloss displays a close code kind title in a list
+func displayWebSocketKindWithLipgloss(kind string) {
+	title := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(wsKindColors[kind]).
+		Render(kind)
+	fmt.Println(title)
+}
+
+// displayWebSocketListItemWithLipgloss displays a single close code in a list
+func displayWebSocketListItemWithLipgloss(info WebSocketCloseCodeInfo) {
+	item := lipgloss.NewStyle().
+		Foreground(wsKindColors[info.Kind]).
+		Render(fmt.Sprintf("  %d", info.Code))
+	fmt.Println(item + " " + info.Name)
+}
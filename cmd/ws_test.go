@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestWebSocketCloseCodesInfoStructure(t *testing.T) {
+	for code, info := range webSocketCloseCodesInfo {
+		t.Run(fmt.Sprintf("ws_%d_structure", code), func(t *testing.T) {
+			if info.Code != code {
+				t.Errorf("Close code key %d does not match code %d", code, info.Code)
+			}
+
+			if info.Name == "" || info.Description == "" || info.Spec == "" {
+				t.Errorf("Close code %d missing Name, Description or Spec", code)
+			}
+
+			if _, exists := wsKindColors[info.Kind]; !exists {
+				t.Errorf("Close code %d has unknown kind %q", code, info.Kind)
+			}
+		})
+	}
+
+	for code := 1000; code <= 1015; code++ {
+		if _, exists := webSocketCloseCodesInfo[code]; !exists {
+			t.Errorf("Close code %d defined by RFC 6455 or IANA is missing", code)
+		}
+	}
+}
+
+func TestFindWebSocketCloseCode(t *testing.T) {
+	tests := []struct {
+		code         int
+		wantKind     string
+		wantSendable bool
+		wantOK       bool
+	}{
+		{1000, wsKindNormal, true, true},
+		{1002, wsKindProtocolError, true, true},
+		{1006, wsKindReserved, false, true},
+		{2500, wsKindReserved, false, true},
+		{3500, wsKindRegistered, true, true},
+		{4001, wsKindPrivate, true, true},
+		{999, "", false, false},
+		{5000, "", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("code_%d", tt.code), func(t *testing.T) {
+			info, ok := findWebSocketCloseCode(tt.code)
+			if ok != tt.wantOK {
+				t.Fatalf("findWebSocketCloseCode(%d) found = %v, expected %v", tt.code, ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if info.Kind != tt.wantKind {
+				t.Errorf("Close code %d kind = %q, expected %q", tt.code, info.Kind, tt.wantKind)
+			}
+			if info.Sendable != tt.wantSendable {
+				t.Errorf("Close code %d sendable = %v, expected %v", tt.code, info.Sendable, tt.wantSendable)
+			}
+		})
+	}
+}
+
+func TestLookupWebSocketCloseCode(t *testing.T) {
+	tests := []struct {
+		name         string
+		arg          string
+		wantContains []string
+	}{
+		{
+			name: "abnormal closure",
+			arg:  "1006",
+			wantContains: []string{
+				"WebSocket 1006 Abnormal Closure",
+				"Reserved",
+				"must not send",
+			},
+		},
+		{
+			name: "private use",
+			arg:  "4404",
+			wantContains: []string{
+				"4404 Application Defined",
+				"Private Use",
+			},
+		},
+		{
+			name: "below range",
+			arg:  "42",
+			wantContains: []string{
+				"close codes start at 1000",
+			},
+		},
+		{
+			name: "out of range",
+			arg:  "6000",
+			wantContains: []string{
+				"WebSocket close code 6000 not found",
+			},
+		},
+		{
+			name: "not a number",
+			arg:  "abc",
+			wantContains: []string{
+				"invalid WebSocket close code abc",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout, _ := captureOutput(func() {
+				lookupWebSocketCloseCode(tt.arg)
+			})
+
+			for _, want := range tt.wantContains {
+				if !strings.Contains(stdout, want) {
+					t.Errorf("Expected '%s' in output, got: %s", want, stdout)
+				}
+			}
+		})
+	}
+}
+
+func TestListWebSocketCloseCodes(t *testing.T) {
+	stdout, _ := captureOutput(func() {
+		listWebSocketCloseCodes()
+	})
+
+	for _, want := range []string{"WebSocket Close Codes", "Protocol Error", "1000 Normal Closure", "3008 Timeout", "4000-4999"} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("Expected '%s' in output, got: %s", want, stdout)
+		}
+	}
+}